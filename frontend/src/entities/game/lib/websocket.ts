@@ -22,6 +22,7 @@ export class GameWebSocket {
   private reconnectAttempts = 0;
   private maxReconnectAttempts = 5;
   private reconnectDelay = 1000;
+  private lastSeq = 0;
   private gameId: string;
   private userId: string;
   private progressIntervalId: number | null = null;
@@ -78,6 +79,9 @@ export class GameWebSocket {
         this.ws.onopen = () => {
           console.log('[GameWS] Соединение установлено');
           this.reconnectAttempts = 0;
+          if (this.lastSeq > 0) {
+            this.sendGameMessage('RESUME', { last_seq: this.lastSeq });
+          }
           resolve();
         };
 
@@ -168,6 +172,10 @@ export class GameWebSocket {
    * Обработать входящее сообщение
    */
   private handleMessage(message: WSMessage): void {
+    if (message.seq !== undefined && message.seq > this.lastSeq) {
+      this.lastSeq = message.seq;
+    }
+
     // Handle PING automatically - respond with PONG for RTT measurement
     if (message.type === 'PING') {
       this.handlePing(message.payload as PingPayload | undefined);
@@ -259,7 +267,9 @@ export class GameWebSocket {
    * Нажать на кнопку
    */
   pressButton(): void {
-    this.sendGameMessage('PRESS_BUTTON');
+    this.sendGameMessage('PRESS_BUTTON', {
+      client_time: Date.now(),
+    });
   }
 
   /**
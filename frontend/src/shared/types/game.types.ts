@@ -30,6 +30,7 @@ export type WSMessageType =
   | 'SUBMIT_ANSWER'
   | 'JUDGE_ANSWER'
   | 'PONG'           // Response to PING for RTT measurement
+  | 'RESUME'         // Replay messages missed while reconnecting
   | 'MEDIA_LOAD_PROGRESS'
   | 'MEDIA_LOAD_COMPLETE'
   | 'TRANSFER_SECRET'      // Host transfers secret question
@@ -51,6 +52,7 @@ export type WSMessageType =
   | 'FOR_ALL_RESULTS';     // ForAll results
 
 export interface WSMessage<T = any> {
+  seq?: number;
   type: WSMessageType;
   payload?: T;
 }
@@ -18,9 +18,12 @@ CREATE TABLE IF NOT EXISTS game_sessions (
     started_at TIMESTAMP,
     finished_at TIMESTAMP,
     created_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
-    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP
+    updated_at TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    settings JSONB NOT NULL DEFAULT '{}'::jsonb
 );
 
+ALTER TABLE game_sessions ADD COLUMN IF NOT EXISTS settings JSONB NOT NULL DEFAULT '{}'::jsonb;
+
 -- Create indexes
 CREATE INDEX IF NOT EXISTS idx_game_sessions_room_id ON game_sessions(room_id);
 CREATE INDEX IF NOT EXISTS idx_game_sessions_status ON game_sessions(status);
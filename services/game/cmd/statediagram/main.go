@@ -0,0 +1,24 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	domainGame "sigame/game/internal/domain/game"
+)
+
+func main() {
+	format := flag.String("format", "mermaid", "output format: mermaid or dot")
+	flag.Parse()
+
+	switch *format {
+	case "mermaid":
+		fmt.Print(domainGame.Machine.Mermaid())
+	case "dot":
+		fmt.Print(domainGame.Machine.Graphviz())
+	default:
+		fmt.Fprintf(os.Stderr, "unknown format: %s\n", *format)
+		os.Exit(1)
+	}
+}
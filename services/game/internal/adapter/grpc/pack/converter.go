@@ -29,6 +29,7 @@ func convertRound(r RoundJSON) *domainPack.Round {
 		ID:          r.ID,
 		RoundNumber: r.RoundNumber,
 		Name:        r.Name,
+		Type:        domainPack.RoundType(r.Type),
 		Themes:      make([]*domainPack.Theme, len(r.Themes)),
 	}
 
@@ -59,9 +60,41 @@ func convertQuestion(q QuestionJSON) *domainPack.Question {
 		Price:           q.Price,
 		Text:            q.Text,
 		Answer:          q.Answer,
+		AltAnswers:      q.AltAnswers,
+		Type:            domainPack.Type(q.Type),
 		MediaType:       q.MediaType,
 		MediaURL:        q.MediaURL,
 		MediaDurationMs: q.MediaDurationMs,
+		Comment:         q.Comment,
+		AnswerMediaType: q.AnswerMediaType,
+		AnswerMediaURL:  q.AnswerMediaURL,
+		SecretParams:    convertSecretParams(q.SecretParams),
+		StakeParams:     convertStakeParams(q.StakeParams),
 		Used:            false,
 	}
 }
+
+func convertSecretParams(p *SecretParamsJSON) *domainPack.SecretParams {
+	if p == nil {
+		return nil
+	}
+
+	return &domainPack.SecretParams{
+		SelectionMode: domainPack.SecretSelectionMode(p.SelectionMode),
+		Theme:         p.Theme,
+		MinPrice:      p.MinPrice,
+		MaxPrice:      p.MaxPrice,
+		PriceStep:     p.PriceStep,
+	}
+}
+
+func convertStakeParams(p *StakeParamsJSON) *domainPack.StakeParams {
+	if p == nil {
+		return nil
+	}
+
+	return &domainPack.StakeParams{
+		MinStake: p.MinStake,
+		MaxStake: p.MaxStake,
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/google/uuid"
+	domainPack "sigame/game/internal/domain/pack"
 )
 
 func TestConvertQuestion(t *testing.T) {
@@ -12,9 +13,13 @@ func TestConvertQuestion(t *testing.T) {
 		Price:          100,
 		Text:            "Test question",
 		Answer:          "Answer",
+		AltAnswers:      []string{"Alt"},
 		MediaType:       "image",
 		MediaURL:        "http://example.com/image.jpg",
 		MediaDurationMs: 5000,
+		Comment:         "Comment",
+		AnswerMediaType: "image",
+		AnswerMediaURL:  "http://example.com/answer.jpg",
 	}
 
 	result := convertQuestion(q)
@@ -31,6 +36,15 @@ func TestConvertQuestion(t *testing.T) {
 	if result.Answer != q.Answer {
 		t.Errorf("convertQuestion() Answer = %s, want %s", result.Answer, q.Answer)
 	}
+	if len(result.AltAnswers) != 1 || result.AltAnswers[0] != "Alt" {
+		t.Errorf("convertQuestion() AltAnswers = %v, want %v", result.AltAnswers, q.AltAnswers)
+	}
+	if result.Comment != q.Comment {
+		t.Errorf("convertQuestion() Comment = %s, want %s", result.Comment, q.Comment)
+	}
+	if result.AnswerMediaType != q.AnswerMediaType || result.AnswerMediaURL != q.AnswerMediaURL {
+		t.Errorf("convertQuestion() answer media = %s %s, want %s %s", result.AnswerMediaType, result.AnswerMediaURL, q.AnswerMediaType, q.AnswerMediaURL)
+	}
 	if result.Used != false {
 		t.Error("convertQuestion() Used should be false")
 	}
@@ -127,3 +141,61 @@ func TestConvertPackResponse_WithError(t *testing.T) {
 	}
 }
 
+
+func TestConvertQuestion_SecretParams(t *testing.T) {
+	q := QuestionJSON{
+		ID:     "q1",
+		Price:  100,
+		Type:   "secret",
+		Answer: "Answer",
+		SecretParams: &SecretParamsJSON{
+			SelectionMode: "exceptCurrent",
+			Theme:         "Secret theme",
+			MinPrice:      100,
+			MaxPrice:      500,
+			PriceStep:     200,
+		},
+	}
+
+	result := convertQuestion(q)
+
+	if result.GetType() != domainPack.TypeSecret {
+		t.Errorf("convertQuestion() Type = %s, want %s", result.GetType(), domainPack.TypeSecret)
+	}
+	if result.SecretParams == nil {
+		t.Fatal("convertQuestion() SecretParams is nil")
+	}
+	if result.SecretParams.GetSelectionMode() != domainPack.SecretSelectionExceptCurrent {
+		t.Errorf("convertQuestion() SelectionMode = %s, want %s", result.SecretParams.GetSelectionMode(), domainPack.SecretSelectionExceptCurrent)
+	}
+	if prices := result.SecretParams.AllowedPrices(q.Price); len(prices) != 3 || prices[2] != 500 {
+		t.Errorf("convertQuestion() AllowedPrices = %v, want [100 300 500]", prices)
+	}
+}
+
+func TestConvertQuestion_StandardType(t *testing.T) {
+	result := convertQuestion(QuestionJSON{ID: "q1", Type: "standard"})
+
+	if result.GetType() != domainPack.TypeNormal {
+		t.Errorf("convertQuestion() Type = %s, want %s", result.GetType(), domainPack.TypeNormal)
+	}
+}
+
+func TestConvertQuestion_StakeParams(t *testing.T) {
+	result := convertQuestion(QuestionJSON{
+		ID:          "q1",
+		Price:       200,
+		Type:        "stake",
+		StakeParams: &StakeParamsJSON{MinStake: 300, MaxStake: 1000},
+	})
+
+	if result.StakeParams == nil {
+		t.Fatal("convertQuestion() StakeParams is nil")
+	}
+	if got := result.StakeParams.GetMinStake(result.Price); got != 300 {
+		t.Errorf("convertQuestion() MinStake = %d, want 300", got)
+	}
+	if got := result.StakeParams.GetMaxStake(); got != 1000 {
+		t.Errorf("convertQuestion() MaxStake = %d, want 1000", got)
+	}
+}
@@ -54,15 +54,33 @@ type ThemeJSON struct {
 }
 
 type QuestionJSON struct {
-	ID              string   `json:"id"`
-	Price           int      `json:"price"`
-	Type            string   `json:"type"`
-	Text            string   `json:"text"`
-	Answer          string   `json:"answer"`
-	AltAnswers      []string `json:"alt_answers"`
-	MediaType       string   `json:"media_type"`
-	MediaURL        string   `json:"media_url"`
-	MediaDurationMs int      `json:"media_duration_ms"`
+	ID              string            `json:"id"`
+	Price           int               `json:"price"`
+	Type            string            `json:"type"`
+	Text            string            `json:"text"`
+	Answer          string            `json:"answer"`
+	AltAnswers      []string          `json:"alt_answers"`
+	MediaType       string            `json:"media_type"`
+	MediaURL        string            `json:"media_url"`
+	MediaDurationMs int               `json:"media_duration_ms"`
+	Comment         string            `json:"comment,omitempty"`
+	AnswerMediaType string            `json:"answer_media_type,omitempty"`
+	AnswerMediaURL  string            `json:"answer_media_url,omitempty"`
+	SecretParams    *SecretParamsJSON `json:"secret_params,omitempty"`
+	StakeParams     *StakeParamsJSON  `json:"stake_params,omitempty"`
+}
+
+type StakeParamsJSON struct {
+	MinStake int `json:"min_stake"`
+	MaxStake int `json:"max_stake"`
+}
+
+type SecretParamsJSON struct {
+	SelectionMode string `json:"selection_mode"`
+	Theme         string `json:"theme"`
+	MinPrice      int    `json:"min_price"`
+	MaxPrice      int    `json:"max_price"`
+	PriceStep     int    `json:"price_step"`
 }
 
 func (c *PackClient) GetPackContent(ctx context.Context, packID uuid.UUID) (*pack.Pack, error) {
@@ -19,8 +19,13 @@ func NewGameRepository(db *sql.DB) *GameRepository {
 }
 
 func (r *GameRepository) CreateGameSession(ctx context.Context, game *domainGame.Game) error {
+	settingsJSON, err := marshalSettings(game.Settings)
+	if err != nil {
+		return ErrCreateGameSession(err)
+	}
+
 	now := time.Now()
-	_, err := r.db.ExecContext(ctx, queryInsertGameSession,
+	_, err = r.db.ExecContext(ctx, queryInsertGameSession,
 		game.ID,
 		game.RoomID,
 		game.PackID,
@@ -29,6 +34,7 @@ func (r *GameRepository) CreateGameSession(ctx context.Context, game *domainGame
 		game.CurrentPhase,
 		now,
 		now,
+		settingsJSON,
 	)
 
 	if err != nil {
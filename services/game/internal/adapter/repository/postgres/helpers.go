@@ -15,6 +15,7 @@ import (
 
 func scanGame(rows *sql.Rows, g *domainGame.Game) error {
 	var startedAt, finishedAt sql.NullTime
+	var settingsJSON []byte
 	err := rows.Scan(
 		&g.ID,
 		&g.RoomID,
@@ -26,6 +27,7 @@ func scanGame(rows *sql.Rows, g *domainGame.Game) error {
 		&finishedAt,
 		&g.CreatedAt,
 		&g.UpdatedAt,
+		&settingsJSON,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to scan game: %w", err)
@@ -34,11 +36,12 @@ func scanGame(rows *sql.Rows, g *domainGame.Game) error {
 	g.StartedAt = handleNullTime(startedAt)
 	g.FinishedAt = handleNullTime(finishedAt)
 
-	return nil
+	return unmarshalSettings(settingsJSON, g)
 }
 
 func scanGameRow(row *sql.Row, g *domainGame.Game) error {
 	var startedAt, finishedAt sql.NullTime
+	var settingsJSON []byte
 	err := row.Scan(
 		&g.ID,
 		&g.RoomID,
@@ -50,6 +53,7 @@ func scanGameRow(row *sql.Row, g *domainGame.Game) error {
 		&finishedAt,
 		&g.CreatedAt,
 		&g.UpdatedAt,
+		&settingsJSON,
 	)
 	if err != nil {
 		return fmt.Errorf("failed to scan game: %w", err)
@@ -58,7 +62,7 @@ func scanGameRow(row *sql.Row, g *domainGame.Game) error {
 	g.StartedAt = handleNullTime(startedAt)
 	g.FinishedAt = handleNullTime(finishedAt)
 
-	return nil
+	return unmarshalSettings(settingsJSON, g)
 }
 
 func scanPlayer(rows *sql.Rows, p *player.Player) error {
@@ -134,6 +138,27 @@ func unmarshalEventData(dataJSON []byte, event *event.Event) error {
 	return nil
 }
 
+func marshalSettings(settings domainGame.Settings) ([]byte, error) {
+	settingsJSON, err := json.Marshal(settings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal game settings: %w", err)
+	}
+
+	return settingsJSON, nil
+}
+
+func unmarshalSettings(settingsJSON []byte, g *domainGame.Game) error {
+	if len(settingsJSON) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(settingsJSON, &g.Settings); err != nil {
+		return fmt.Errorf("failed to unmarshal game settings: %w", err)
+	}
+
+	return nil
+}
+
 func handleNullTime(nullTime sql.NullTime) *time.Time {
 	if nullTime.Valid {
 		return &nullTime.Time
@@ -7,6 +7,7 @@ import (
 
 	"github.com/google/uuid"
 	"sigame/game/internal/domain/event"
+	domainGame "sigame/game/internal/domain/game"
 )
 
 func TestHandleNullTime(t *testing.T) {
@@ -136,3 +137,37 @@ func TestUnmarshalEventData(t *testing.T) {
 }
 
 
+
+func TestSettingsRoundTrip(t *testing.T) {
+	settings := domainGame.Settings{
+		TimeForAnswer:      15,
+		TimeForChoice:      10,
+		RoundsOverviewTime: 2,
+		JudgingTime:        60,
+		ButtonWindowMs:     300,
+		Scoring:            domainGame.ScoringRules{AllowNegative: true},
+	}
+
+	settingsJSON, err := marshalSettings(settings)
+	if err != nil {
+		t.Fatalf("marshalSettings() error = %v", err)
+	}
+
+	g := &domainGame.Game{}
+	if err := unmarshalSettings(settingsJSON, g); err != nil {
+		t.Fatalf("unmarshalSettings() error = %v", err)
+	}
+	if g.Settings.TimeForAnswer != 15 || g.Settings.RoundsOverviewTime != 2 || g.Settings.JudgingTime != 60 || g.Settings.ButtonWindowMs != 300 {
+		t.Errorf("unmarshalSettings() settings = %+v, want %+v", g.Settings, settings)
+	}
+	if !g.Settings.Scoring.AllowNegative {
+		t.Error("unmarshalSettings() lost scoring rules")
+	}
+
+	if err := unmarshalSettings(nil, g); err != nil {
+		t.Errorf("unmarshalSettings() with empty data error = %v", err)
+	}
+	if err := unmarshalSettings([]byte(`{invalid json}`), g); err == nil {
+		t.Error("unmarshalSettings() with invalid JSON should fail")
+	}
+}
@@ -17,6 +17,7 @@ const (
 	colFinishedAt   = "finished_at"
 	colCreatedAt    = "created_at"
 	colUpdatedAt    = "updated_at"
+	colSettings     = "settings"
 	colGameID       = "game_id"
 	colUserID       = "user_id"
 	colUsername     = "username"
@@ -34,8 +35,8 @@ const (
 
 const (
 	queryInsertGameSession = `
-		INSERT INTO game_sessions (id, room_id, pack_id, status, current_round, current_phase, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		INSERT INTO game_sessions (id, room_id, pack_id, status, current_round, current_phase, created_at, updated_at, settings)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 	`
 
 	queryUpdateGameSession = `
@@ -47,7 +48,7 @@ const (
 
 	querySelectGameSession = `
 		SELECT id, room_id, pack_id, status, current_round, current_phase, 
-		       started_at, finished_at, created_at, updated_at
+		       started_at, finished_at, created_at, updated_at, settings
 		FROM game_sessions
 		WHERE id = $1
 	`
@@ -60,7 +61,7 @@ const (
 
 	querySelectGamesByRoomID = `
 		SELECT id, room_id, pack_id, status, current_round, current_phase, 
-		       started_at, finished_at, created_at, updated_at
+		       started_at, finished_at, created_at, updated_at, settings
 		FROM game_sessions
 		WHERE room_id = $1
 		ORDER BY created_at DESC
@@ -68,7 +69,7 @@ const (
 
 	querySelectActiveGameForUser = `
 		SELECT gs.id, gs.room_id, gs.pack_id, gs.status, gs.current_round, gs.current_phase, 
-		       gs.started_at, gs.finished_at, gs.created_at, gs.updated_at
+		       gs.started_at, gs.finished_at, gs.created_at, gs.updated_at, gs.settings
 		FROM game_sessions gs
 		INNER JOIN game_players gp ON gs.id = gp.game_id
 		WHERE gp.user_id = $1 
@@ -128,6 +128,7 @@ func TestColumnConstants(t *testing.T) {
 		colFinishedAt,
 		colCreatedAt,
 		colUpdatedAt,
+		colSettings,
 		colGameID,
 		colUserID,
 		colUsername,
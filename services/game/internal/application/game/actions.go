@@ -0,0 +1,56 @@
+package game
+
+import (
+	"sigame/game/internal/infrastructure/logger"
+	wsMessage "sigame/game/internal/transport/ws/message"
+)
+
+type actionHandler struct {
+	decode func(msg ClientMessage) (interface{}, error)
+	handle func(m *Manager, action *PlayerAction, payload interface{})
+}
+
+var actionHandlers = make(map[wsMessage.MessageType]actionHandler)
+
+func registerAction[P any](msgType wsMessage.MessageType, handle func(m *Manager, action *PlayerAction, payload *P)) {
+	if _, exists := actionHandlers[msgType]; exists {
+		panic("game: duplicate handler for " + string(msgType))
+	}
+
+	actionHandlers[msgType] = actionHandler{
+		decode: func(msg ClientMessage) (interface{}, error) {
+			payload := new(P)
+			if err := msg.DecodePayload(payload); err != nil {
+				return nil, err
+			}
+			return payload, nil
+		},
+		handle: func(m *Manager, action *PlayerAction, payload interface{}) {
+			handle(m, action, payload.(*P))
+		},
+	}
+}
+
+func withoutPayload(handle func(m *Manager, action *PlayerAction)) func(*Manager, *PlayerAction, *wsMessage.EmptyPayload) {
+	return func(m *Manager, action *PlayerAction, _ *wsMessage.EmptyPayload) {
+		handle(m, action)
+	}
+}
+
+func (m *Manager) dispatchAction(action *PlayerAction) {
+	handler, ok := actionHandlers[wsMessage.MessageType(action.Message.GetType())]
+	if !ok {
+		logger.Warnf(m.ctx, "[handlePlayerAction] Unknown message type %s from %s", action.Message.GetType(), action.UserID)
+		m.reject(action, wsMessage.ErrorCodeUnknownMessage, "unknown message type")
+		return
+	}
+
+	payload, err := handler.decode(action.Message)
+	if err != nil {
+		logger.Warnf(m.ctx, "[handlePlayerAction] Rejected %s from %s: %v", action.Message.GetType(), action.UserID, err)
+		m.reject(action, wsMessage.ErrorCodeInvalidPayload, err.Error())
+		return
+	}
+
+	handler.handle(m, action, payload)
+}
@@ -4,25 +4,21 @@ import "time"
 
 const (
 	ManagerActionChannelBuffer = 100
-	RoundsOverviewDuration     = 5 * time.Second
-	RoundIntroDuration         = 3 * time.Second
-	AnswerJudgingDuration      = 30 * time.Second
+	SchedulerChannelBuffer     = 16
 	TimerUpdateInterval        = 1 * time.Second
 	InitialRoundNumber         = 0
 	FirstRoundNumber            = InitialRoundNumber + 1
 	MaxIntValue                 = int(^uint(0) >> 1)
 
-	QuestionReadDuration         = 3 * time.Second
-	SecretTransferDuration       = 30 * time.Second
-	StakeBettingDuration         = 20 * time.Second
-	ButtonPressCollectionWindow  = 150 * time.Millisecond
-	MediaStartDelay              = 300 * time.Millisecond
-	ForAllResultsDisplayDuration = 5 * time.Second
-	RoundEndDelay                = 5 * time.Second
-	TopWinnersCount              = 3
-	MediaIDSuffix                = "_media"
-	DefaultMediaDurationMs       = 5000
-	MaxSaveRetries               = 3
-	SaveRetryDelay               = 500 * time.Millisecond
+	MinQuestionReadDuration = 3 * time.Second
+	ReadProgressInterval    = 250 * time.Millisecond
+	MediaStartDelay         = 300 * time.Millisecond
+	TopWinnersCount         = 3
+	MediaIDSuffix           = "_media"
+	DefaultMediaDurationMs  = 5000
+	MaxSaveRetries          = 3
+	SaveRetryDelay          = 500 * time.Millisecond
+
+	FinalMinStake = 1
 )
 
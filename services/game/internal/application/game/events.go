@@ -0,0 +1,50 @@
+package game
+
+import (
+	"sigame/game/internal/core/button"
+	"sigame/game/internal/domain/player"
+	wsMessage "sigame/game/internal/transport/ws/message"
+)
+
+func (m *Manager) broadcastButtonPressed(winner *button.PressEntry) {
+	presses := m.buttonPress.GetAllPresses()
+	allPresses := make([]wsMessage.PressInfo, 0, len(presses))
+	for i := range presses {
+		allPresses = append(allPresses, wsMessage.PressInfo{
+			UserID:   presses[i].UserID,
+			Username: presses[i].Username,
+			TimeMS:   m.buttonPress.GetReactionTime(&presses[i]),
+		})
+	}
+
+	var falseStarts []wsMessage.FalseStartInfo
+	for _, entry := range m.buttonPress.FalseStarts() {
+		falseStarts = append(falseStarts, wsMessage.FalseStartInfo{
+			UserID:   entry.UserID,
+			Username: entry.Username,
+		})
+	}
+
+	msg := wsMessage.NewButtonPressedMessage(winner.UserID, winner.Username, m.buttonPress.GetReactionTime(winner), allPresses, falseStarts)
+	m.hub.Broadcast(m.game.ID, msg)
+}
+
+func (m *Manager) broadcastAnswerResult(p *player.Player, correct bool, delta int) {
+	answer := ""
+	if m.answerMatch != nil && m.answerMatch.UserID == p.UserID {
+		answer = m.answerMatch.Answer
+	}
+
+	msg := wsMessage.NewAnswerResultMessage(p.UserID, p.Username, correct, answer, p.Score, delta)
+	m.hub.Broadcast(m.game.ID, msg)
+}
+
+func (m *Manager) broadcastRoundComplete(roundNumber int, nextRound *int) {
+	msg := wsMessage.NewRoundCompleteMessage(roundNumber, m.calculateFinalScores(), nextRound)
+	m.hub.Broadcast(m.game.ID, msg)
+}
+
+func (m *Manager) broadcastGameComplete() {
+	msg := wsMessage.NewGameCompleteMessage(m.game.Winners, m.game.FinalScores)
+	m.hub.Broadcast(m.game.ID, msg)
+}
@@ -0,0 +1,401 @@
+package game
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+	"sigame/game/internal/domain/event"
+	domainGame "sigame/game/internal/domain/game"
+	"sigame/game/internal/domain/pack"
+	"sigame/game/internal/domain/player"
+	"sigame/game/internal/infrastructure/logger"
+	wsMessage "sigame/game/internal/transport/ws/message"
+)
+
+func init() {
+	registerAction(wsMessage.MessageTypeRemoveFinalTheme, (*Manager).handleRemoveFinalTheme)
+	registerAction(wsMessage.MessageTypePlaceFinalStake, (*Manager).handlePlaceFinalStake)
+	registerAction(wsMessage.MessageTypeSubmitFinalAnswer, (*Manager).handleSubmitFinalAnswer)
+	registerAction(wsMessage.MessageTypeJudgeFinalAnswer, (*Manager).handleJudgeFinalAnswer)
+}
+
+func (m *Manager) beginFinalRound() {
+	participants := m.finalParticipants()
+	round := m.pack.GetRound(m.game.CurrentRound)
+
+	if len(participants) == 0 || round == nil || len(round.Themes) == 0 {
+		logger.Infof(m.ctx, "[beginFinalRound] Skipping final round: participants=%d", len(participants))
+		m.endGame()
+		return
+	}
+
+	m.game.Final = domainGame.NewFinalRound(participants)
+	m.game.ClearCurrentQuestion()
+	logger.Infof(m.ctx, "[beginFinalRound] Final round started with %d participants", len(participants))
+
+	m.advanceFinalThemeSelect()
+}
+
+func (m *Manager) finalParticipants() []uuid.UUID {
+	contestants := make([]*player.Player, 0)
+	for _, p := range m.game.Players {
+		if p.Role.IsPlayer() && p.IsActive && p.Score > 0 {
+			contestants = append(contestants, p)
+		}
+	}
+
+	sort.Slice(contestants, func(i, j int) bool {
+		if contestants[i].Score != contestants[j].Score {
+			return contestants[i].Score < contestants[j].Score
+		}
+		return contestants[i].UserID.String() < contestants[j].UserID.String()
+	})
+
+	participants := make([]uuid.UUID, 0, len(contestants))
+	for _, p := range contestants {
+		participants = append(participants, p.UserID)
+	}
+	return participants
+}
+
+func (m *Manager) remainingFinalThemes() []*pack.Theme {
+	round := m.pack.GetRound(m.game.CurrentRound)
+	if round == nil {
+		return nil
+	}
+
+	remaining := make([]*pack.Theme, 0, len(round.Themes))
+	for _, theme := range round.Themes {
+		if !m.game.Final.IsThemeRemoved(theme.Name) {
+			remaining = append(remaining, theme)
+		}
+	}
+	return remaining
+}
+
+func (m *Manager) advanceFinalThemeSelect() {
+	remaining := m.remainingFinalThemes()
+	if len(remaining) <= 1 {
+		m.startFinalStakes(remaining)
+		return
+	}
+
+	m.game.SetActivePlayer(m.game.Final.CurrentTurn())
+	if !m.transition(domainGame.StatusFinalThemeSelect) {
+		return
+	}
+	m.BroadcastState()
+	m.timer.Start(time.Duration(m.game.Settings.TimeForChoice) * time.Second)
+}
+
+func (m *Manager) handleRemoveFinalTheme(action *PlayerAction, payload *wsMessage.RemoveFinalThemePayload) {
+	if m.game.Status != domainGame.StatusFinalThemeSelect || m.game.Final == nil {
+		logger.Warnf(m.ctx, "[REMOVE_FINAL_THEME] Invalid game status: %s, expected: %s", m.game.Status, domainGame.StatusFinalThemeSelect)
+		m.reject(action, wsMessage.ErrorCodeInvalidPhase, "final themes are not being removed")
+		return
+	}
+
+	round := m.pack.GetRound(m.game.CurrentRound)
+	if round == nil {
+		m.reject(action, wsMessage.ErrorCodeInvalidPhase, "no round in progress")
+		return
+	}
+
+	theme := round.FindTheme(payload.ThemeID)
+	if theme == nil {
+		logger.Warnf(m.ctx, "[REMOVE_FINAL_THEME] Theme not found: %s", payload.ThemeID)
+		m.reject(action, wsMessage.ErrorCodeInvalidPayload, "theme not found")
+		return
+	}
+
+	if err := m.removeFinalTheme(action.UserID, theme); err != nil {
+		m.rejectErr(action, err)
+	}
+}
+
+func (m *Manager) removeFinalTheme(userID uuid.UUID, theme *pack.Theme) error {
+	if err := m.game.Final.RemoveTheme(userID, theme.Name); err != nil {
+		logger.Warnf(m.ctx, "[removeFinalTheme] User %s cannot remove theme %s: %v", userID, theme.Name, err)
+		return err
+	}
+
+	m.timer.Stop()
+
+	evt := event.New(m.game.ID, event.TypeFinalThemeRemoved).
+		WithUser(userID).
+		WithRound(m.game.CurrentRound).
+		WithData("theme", theme.Name)
+	m.eventLogger.LogEvent(context.Background(), evt)
+
+	m.advanceFinalThemeSelect()
+	return nil
+}
+
+func (m *Manager) handleFinalThemeSelectTimeout() {
+	remaining := m.remainingFinalThemes()
+	if len(remaining) == 0 {
+		m.startFinalStakes(remaining)
+		return
+	}
+
+	if err := m.removeFinalTheme(m.game.Final.CurrentTurn(), remaining[0]); err != nil {
+		logger.Errorf(m.ctx, "[handleFinalThemeSelectTimeout] Failed to remove theme %s: %v", remaining[0].Name, err)
+		m.startFinalStakes(remaining)
+	}
+}
+
+func (m *Manager) startFinalStakes(remaining []*pack.Theme) {
+	if len(remaining) == 0 || len(remaining[0].Questions) == 0 {
+		logger.Warnf(m.ctx, "[startFinalStakes] No final question left, ending round")
+		m.endRound()
+		return
+	}
+
+	theme := remaining[0]
+	question := theme.Questions[0]
+	if available := theme.GetAvailableQuestions(); len(available) > 0 {
+		question = available[0]
+	}
+
+	question.MarkAsUsed()
+	m.game.SetCurrentQuestion(question, theme.Name)
+	m.game.ActivePlayer = nil
+
+	if !m.transition(domainGame.StatusFinalStake) {
+		return
+	}
+	m.BroadcastState()
+	m.timer.Start(m.game.Settings.GetFinalStakeDuration())
+}
+
+func (m *Manager) handlePlaceFinalStake(action *PlayerAction, payload *wsMessage.PlaceFinalStakePayload) {
+	if m.game.Status != domainGame.StatusFinalStake || m.game.Final == nil {
+		logger.Warnf(m.ctx, "[PLACE_FINAL_STAKE] Invalid game status: %s, expected: %s", m.game.Status, domainGame.StatusFinalStake)
+		m.reject(action, wsMessage.ErrorCodeInvalidPhase, "final stakes are not being accepted")
+		return
+	}
+
+	if err := m.placeFinalStake(action.UserID, payload.Amount); err != nil {
+		m.rejectErr(action, err)
+		return
+	}
+
+	if m.game.Final.AllStaked() {
+		m.timer.Stop()
+		m.startFinalAnswering()
+	}
+}
+
+func (m *Manager) placeFinalStake(userID uuid.UUID, amount int) error {
+	p, ok := m.game.Players[userID]
+	if !ok {
+		return domainGame.ErrPlayerNotFound
+	}
+
+	if amount < FinalMinStake {
+		logger.Warnf(m.ctx, "[placeFinalStake] User %s stake %d is below minimum %d", userID, amount, FinalMinStake)
+		return domainGame.ErrStakeTooLow
+	}
+	if amount > p.Score {
+		logger.Warnf(m.ctx, "[placeFinalStake] User %s stake %d exceeds score %d", userID, amount, p.Score)
+		return domainGame.ErrStakeTooHigh
+	}
+
+	if err := m.game.Final.PlaceStake(userID, amount); err != nil {
+		logger.Warnf(m.ctx, "[placeFinalStake] User %s cannot place stake: %v", userID, err)
+		return err
+	}
+
+	evt := event.New(m.game.ID, event.TypeFinalStakePlaced).
+		WithUser(userID).
+		WithRound(m.game.CurrentRound).
+		WithData("amount", amount)
+	m.eventLogger.LogEvent(context.Background(), evt)
+
+	m.BroadcastState()
+	return nil
+}
+
+func (m *Manager) handleFinalStakeTimeout() {
+	for _, userID := range m.game.Final.Participants {
+		if _, placed := m.game.Final.Stakes[userID]; !placed {
+			if err := m.placeFinalStake(userID, FinalMinStake); err != nil {
+				logger.Errorf(m.ctx, "[handleFinalStakeTimeout] Failed to place minimum stake for %s: %v", userID, err)
+			}
+		}
+	}
+
+	m.startFinalAnswering()
+}
+
+func (m *Manager) startFinalAnswering() {
+	if !m.transition(domainGame.StatusFinalAnswering) {
+		return
+	}
+	m.BroadcastState()
+
+	if m.game.CurrentQuestion != nil && m.game.CurrentQuestion.HasMedia() {
+		m.sendStartMedia(m.game.CurrentQuestion)
+	}
+
+	m.timer.Start(m.game.Settings.GetFinalAnswerDuration())
+}
+
+func (m *Manager) handleSubmitFinalAnswer(action *PlayerAction, payload *wsMessage.SubmitFinalAnswerPayload) {
+	if m.game.Status != domainGame.StatusFinalAnswering || m.game.Final == nil {
+		logger.Warnf(m.ctx, "[SUBMIT_FINAL_ANSWER] Invalid game status: %s, expected: %s", m.game.Status, domainGame.StatusFinalAnswering)
+		m.reject(action, wsMessage.ErrorCodeInvalidPhase, "final answers are not being accepted")
+		return
+	}
+
+	if err := m.game.Final.SubmitAnswer(action.UserID, payload.Answer); err != nil {
+		logger.Warnf(m.ctx, "[SUBMIT_FINAL_ANSWER] User %s cannot submit answer: %v", action.UserID, err)
+		m.rejectErr(action, err)
+		return
+	}
+
+	evt := event.New(m.game.ID, event.TypeFinalAnswerSubmitted).
+		WithUser(action.UserID).
+		WithRound(m.game.CurrentRound).
+		WithData("answer", payload.Answer)
+	m.eventLogger.LogEvent(context.Background(), evt)
+
+	if m.game.Final.AllAnswered() {
+		m.timer.Stop()
+		m.startFinalJudging()
+		return
+	}
+
+	m.BroadcastState()
+}
+
+func (m *Manager) startFinalJudging() {
+	userID, ok := m.game.Final.NextToJudge()
+	if !ok {
+		m.game.ActivePlayer = nil
+		m.endRound()
+		return
+	}
+
+	m.game.SetActivePlayer(userID)
+	if !m.transition(domainGame.StatusFinalJudging) {
+		return
+	}
+	m.BroadcastState()
+	m.timer.Start(m.game.Settings.GetJudgingDuration())
+}
+
+func (m *Manager) handleJudgeFinalAnswer(action *PlayerAction, payload *wsMessage.JudgeFinalAnswerPayload) {
+	if m.game.Status != domainGame.StatusFinalJudging || m.game.Final == nil {
+		logger.Warnf(m.ctx, "[JUDGE_FINAL_ANSWER] Invalid game status: %s, expected: %s", m.game.Status, domainGame.StatusFinalJudging)
+		m.reject(action, wsMessage.ErrorCodeInvalidPhase, "no final answer is being judged")
+		return
+	}
+
+	if m.game.Players[action.UserID].Role != player.RoleHost {
+		m.reject(action, wsMessage.ErrorCodeNotHost, "only the host can judge answers")
+		return
+	}
+
+	userID, correct := payload.UserID, payload.Correct
+	if m.game.ActivePlayer == nil || *m.game.ActivePlayer != userID {
+		logger.Warnf(m.ctx, "[JUDGE_FINAL_ANSWER] Answer of %s is not being revealed, active: %v", userID, m.game.ActivePlayer)
+		m.reject(action, wsMessage.ErrorCodeNotYourTurn, "this answer is not being revealed")
+		return
+	}
+
+	m.judgeFinalAnswer(userID, correct)
+}
+
+func (m *Manager) handleFinalJudgingTimeout() {
+	if m.game.ActivePlayer == nil {
+		m.startFinalJudging()
+		return
+	}
+
+	userID := *m.game.ActivePlayer
+	correct := m.matchAnswer(m.game.Final.Answers[userID]).Matched
+	m.judgeFinalAnswer(userID, correct)
+}
+
+func (m *Manager) judgeFinalAnswer(userID uuid.UUID, correct bool) {
+	if err := m.game.Final.Judge(userID, correct); err != nil {
+		logger.Warnf(m.ctx, "[judgeFinalAnswer] Cannot judge answer of %s: %v", userID, err)
+		return
+	}
+
+	m.timer.Stop()
+
+	stake := m.game.Final.Stakes[userID]
+	m.scorer.Stake(m.game.Players[userID], correct, stake)
+
+	eventType := event.TypeAnswerIncorrect
+	if correct {
+		eventType = event.TypeAnswerCorrect
+	}
+	evt := event.New(m.game.ID, eventType).
+		WithUser(userID).
+		WithRound(m.game.CurrentRound).
+		WithData("stake", stake)
+	m.eventLogger.LogEvent(context.Background(), evt)
+
+	m.startFinalJudging()
+}
+
+func (m *Manager) buildFinalState() *domainGame.FinalState {
+	final := m.game.Final
+
+	state := &domainGame.FinalState{
+		Participants:     final.Participants,
+		RemovedThemes:    final.RemovedThemes,
+		StakesPlaced:     make([]uuid.UUID, 0, len(final.Stakes)),
+		AnswersSubmitted: make([]uuid.UUID, 0, len(final.Answers)),
+		Stakes:           make(map[uuid.UUID]int, len(final.Stakes)),
+		Answers:          make([]domainGame.FinalAnswer, 0, len(final.Answers)),
+	}
+
+	if m.game.Status == domainGame.StatusFinalThemeSelect {
+		turn := final.CurrentTurn()
+		state.TurnPlayer = &turn
+	}
+
+	for _, userID := range final.Participants {
+		stake, staked := final.Stakes[userID]
+		if staked {
+			state.StakesPlaced = append(state.StakesPlaced, userID)
+			state.Stakes[userID] = stake
+		}
+
+		answer, answered := final.Answers[userID]
+		if answered {
+			state.AnswersSubmitted = append(state.AnswersSubmitted, userID)
+		}
+
+		if m.game.Status != domainGame.StatusFinalJudging && m.game.Status != domainGame.StatusRoundEnd && m.game.Status != domainGame.StatusGameEnd {
+			continue
+		}
+
+		username := ""
+		if p, ok := m.game.Players[userID]; ok {
+			username = p.Username
+		}
+		correct, judged := final.Verdicts[userID]
+		confidence := 0.0
+		if answered {
+			confidence = m.matchAnswer(answer).Confidence
+		}
+		state.Answers = append(state.Answers, domainGame.FinalAnswer{
+			UserID:     userID,
+			Username:   username,
+			Answer:     answer,
+			Stake:      stake,
+			Judged:     judged,
+			Correct:    correct,
+			Confidence: confidence,
+		})
+	}
+
+	return state
+}
@@ -4,6 +4,8 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"sigame/game/internal/core/answer"
+	"sigame/game/internal/core/button"
 	domainGame "sigame/game/internal/domain/game"
 	"sigame/game/internal/domain/pack"
 	"sigame/game/internal/domain/player"
@@ -11,6 +13,17 @@ import (
 	wsMessage "sigame/game/internal/transport/ws/message"
 )
 
+func init() {
+	registerAction(wsMessage.MessageTypeReady, withoutPayload((*Manager).handleReady))
+	registerAction(wsMessage.MessageTypeSelectQuestion, (*Manager).handleSelectQuestion)
+	registerAction(wsMessage.MessageTypePressButton, (*Manager).handlePressButton)
+	registerAction(wsMessage.MessageTypeSubmitAnswer, (*Manager).handleSubmitAnswer)
+	registerAction(wsMessage.MessageTypeJudgeAnswer, (*Manager).handleJudgeAnswer)
+	registerAction(wsMessage.MessageTypeMediaLoadProgress, (*Manager).handleMediaLoadProgress)
+	registerAction(wsMessage.MessageTypeMediaLoadComplete, (*Manager).handleMediaLoadComplete)
+	registerAction(wsMessage.MessageTypeSubmitForAllAnswer, (*Manager).handleSubmitForAllAnswer)
+}
+
 func (m *Manager) findHost() uuid.UUID {
 	for userID, p := range m.game.Players {
 		if p.Role == player.RoleHost {
@@ -25,7 +38,7 @@ func (m *Manager) selectActivePlayer() uuid.UUID {
 	minScore := MaxIntValue
 
 	for userID, p := range m.game.Players {
-		if p.Role == player.RoleHost {
+		if !p.Role.IsPlayer() {
 			continue
 		}
 		if p.IsActive && p.Score < minScore {
@@ -37,49 +50,36 @@ func (m *Manager) selectActivePlayer() uuid.UUID {
 	return selectedPlayer
 }
 
-func (m *Manager) handleSelectQuestion(action *PlayerAction) {
+func (m *Manager) handleSelectQuestion(action *PlayerAction, payload *wsMessage.SelectQuestionPayload) {
 	if m.game.Status != domainGame.StatusQuestionSelect {
 		logger.Warnf(m.ctx, "[SELECT_QUESTION] Invalid game status: %s, expected: %s", m.game.Status, domainGame.StatusQuestionSelect)
+		m.reject(action, wsMessage.ErrorCodeInvalidPhase, "questions can only be selected during question selection")
 		return
 	}
 
 	p, ok := m.game.Players[action.UserID]
 	if !ok {
 		logger.Warnf(m.ctx, "[SELECT_QUESTION] Player not found: %s", action.UserID)
+		m.reject(action, wsMessage.ErrorCodePlayerNotFound, "player not found")
 		return
 	}
-	if p.Role != player.RoleHost {
-		logger.Warnf(m.ctx, "[SELECT_QUESTION] Player is not host: %s, role: %s", action.UserID, p.Role)
+	if !m.isSelector(p) {
+		logger.Warnf(m.ctx, "[SELECT_QUESTION] Player is not the selector: %s, role: %s, policy: %s", action.UserID, p.Role, m.game.Settings.GetSelectionPolicy())
+		if m.game.Settings.GetSelectionPolicy() == domainGame.SelectionByHost {
+			m.reject(action, wsMessage.ErrorCodeNotHost, "only the host can select questions")
+		} else {
+			m.reject(action, wsMessage.ErrorCodeNotYourTurn, "another player is selecting the question")
+		}
 		return
 	}
 
-	payload := action.Message.GetPayload()
-	themeIDRaw, ok := payload["theme_id"]
-	if !ok {
-		logger.Warnf(m.ctx, "[SELECT_QUESTION] Missing theme_id in payload: %v", payload)
-		return
-	}
-	themeID, ok := themeIDRaw.(string)
-	if !ok {
-		logger.Warnf(m.ctx, "[SELECT_QUESTION] Invalid theme_id type: %T, value: %v", themeIDRaw, themeIDRaw)
-		return
-	}
+	themeID, questionID := payload.ThemeID, payload.QuestionID
 
-	questionIDRaw, ok := payload["question_id"]
-	if !ok {
-		logger.Warnf(m.ctx, "[SELECT_QUESTION] Missing question_id in payload: %v", payload)
-		return
-	}
-	questionID, ok := questionIDRaw.(string)
-	if !ok {
-		logger.Warnf(m.ctx, "[SELECT_QUESTION] Invalid question_id type: %T, value: %v", questionIDRaw, questionIDRaw)
-		return
-	}
-	
 	logger.Infof(m.ctx, "[SELECT_QUESTION] Processing: theme_id=%s, question_id=%s, user_id=%s", themeID, questionID, action.UserID)
 
 	round := m.pack.GetRound(m.game.CurrentRound)
 	if round == nil {
+		m.reject(action, wsMessage.ErrorCodeInvalidPhase, "no round in progress")
 		return
 	}
 
@@ -100,6 +100,7 @@ func (m *Manager) handleSelectQuestion(action *PlayerAction) {
 	}
 
 	if selectedQuestion == nil || selectedTheme == nil {
+		m.reject(action, wsMessage.ErrorCodeQuestionUnavailable, "question is not available")
 		return
 	}
 
@@ -109,9 +110,12 @@ func (m *Manager) handleSelectQuestion(action *PlayerAction) {
 func (m *Manager) selectQuestion(theme *pack.Theme, question *pack.Question) {
 	question.MarkAsUsed()
 	m.game.SetCurrentQuestion(question, theme.Name)
+	m.buttonPress.Reset()
+	m.snapshotScores()
 
 	m.stakeInfo = nil
 	m.secretTarget = nil
+	m.secretInfo = nil
 
 	questionType := question.GetType()
 	logger.Infof(m.ctx, "[selectQuestion] Selected question: id=%s, type=%s, price=%d", question.ID, questionType, question.Price)
@@ -128,86 +132,39 @@ func (m *Manager) selectQuestion(theme *pack.Theme, question *pack.Question) {
 }
 
 func (m *Manager) startNormalQuestion(question *pack.Question) {
-	m.game.UpdateStatus(domainGame.StatusQuestionShow)
-	m.BroadcastState()
-
-	if question.HasMedia() {
-		m.sendStartMedia(question)
-	}
-
-	readTime := QuestionReadDuration
-	if question.MediaDurationMs > 0 {
-		readTime += time.Duration(question.MediaDurationMs) * time.Millisecond
+	if !m.transition(domainGame.StatusQuestionShow) {
+		return
 	}
-	m.timer.Start(readTime)
-}
-
-func (m *Manager) startSecretQuestion(question *pack.Question) {
-	logger.Infof(m.ctx, "[startSecretQuestion] Starting secret question, price: %d", question.Price)
-	m.game.UpdateStatus(domainGame.StatusSecretTransfer)
 	m.BroadcastState()
-	m.timer.Start(SecretTransferDuration)
-	logger.Infof(m.ctx, "[startSecretQuestion] Status changed to: %s, timer started for %v", m.game.Status, SecretTransferDuration)
-}
-
-func (m *Manager) startStakeQuestion(question *pack.Question) {
-	logger.Infof(m.ctx, "[startStakeQuestion] Starting stake question, price: %d", question.Price)
-	activePlayerID := m.selectActivePlayer()
-	m.game.SetActivePlayer(activePlayerID)
-
-	activePlayer := m.game.Players[activePlayerID]
-
-	minBet := question.Price
-	maxBet := activePlayer.Score
-	if maxBet < minBet {
-		maxBet = minBet
-	}
 
-	m.stakeInfo = &domainGame.StakeInfo{
-		MinBet:     minBet,
-		MaxBet:     maxBet,
-		CurrentBet: 0,
-		IsAllIn:    false,
-	}
-
-	logger.Infof(m.ctx, "[startStakeQuestion] Active player: %s, minBet: %d, maxBet: %d", activePlayerID, minBet, maxBet)
-	m.game.UpdateStatus(domainGame.StatusStakeBetting)
-	m.BroadcastState()
-	m.timer.Start(StakeBettingDuration)
-	logger.Infof(m.ctx, "[startStakeQuestion] Status changed to: %s, timer started for %v", m.game.Status, StakeBettingDuration)
+	m.timer.Start(m.startReading(question))
 }
 
 func (m *Manager) startForAllQuestion(question *pack.Question) {
 	logger.Infof(m.ctx, "[startForAllQuestion] Starting forAll question, price: %d", question.Price)
 	m.forAllCollector.Start(question.Answer, question.Price)
 
-	m.game.UpdateStatus(domainGame.StatusQuestionShow)
-	m.BroadcastState()
-
-	if question.HasMedia() {
-		m.sendStartMedia(question)
+	if !m.transition(domainGame.StatusQuestionShow) {
+		return
 	}
+	m.BroadcastState()
 
-	readTime := QuestionReadDuration
-	if question.MediaDurationMs > 0 {
-		readTime += time.Duration(question.MediaDurationMs) * time.Millisecond
-	}
+	readTime := m.startReading(question)
 
 	logger.Infof(m.ctx, "[startForAllQuestion] Status changed to: %s, readTime: %v", m.game.Status, readTime)
-	go func() {
-		time.Sleep(readTime)
-		m.mu.Lock()
-		defer m.mu.Unlock()
-
+	m.schedule(tagForAllRead, readTime, func() {
 		if m.game.Status == domainGame.StatusQuestionShow && m.game.CurrentQuestion != nil &&
 			m.game.CurrentQuestion.GetType() == pack.TypeForAll {
 			logger.Infof(m.ctx, "[startForAllQuestion] Transitioning to forAllAnswering after readTime")
-			m.game.UpdateStatus(domainGame.StatusForAllAnswering)
+			m.finishReading()
+			if !m.transition(domainGame.StatusForAllAnswering) {
+				return
+			}
 			m.BroadcastState()
 			m.timer.Start(time.Duration(m.game.Settings.TimeForAnswer) * time.Second)
 			logger.Infof(m.ctx, "[startForAllQuestion] Status changed to: %s, timer started", m.game.Status)
 		}
-	}()
+	})
 }
 
 func (m *Manager) sendStartMedia(question *pack.Question) {
@@ -242,7 +199,7 @@ func (m *Manager) sendStartMedia(question *pack.Question) {
 		return
 	}
 
-	now := time.Now().UnixMilli()
+	now := m.clock.Now().UnixMilli()
 	durationMs := int64(question.MediaDurationMs)
 	if durationMs == 0 {
 		durationMs = DefaultMediaDurationMs
@@ -256,59 +213,107 @@ func (m *Manager) sendStartMedia(question *pack.Question) {
 		durationMs,
 	)
 
-	data, err := msg.ToJSON()
-	if err != nil {
-		logger.Errorf(nil, "%v", ErrSerializeStartMediaMessage(err))
-		return
-	}
+	m.hub.Broadcast(m.game.ID, msg)
+}
 
-	m.hub.Broadcast(m.game.ID, data)
+func (m *Manager) handleReady(action *PlayerAction) {
+	logger.Infof(m.ctx, "[READY] Player %s is ready, game status: %s", action.UserID, m.game.Status)
 }
 
-func (m *Manager) handlePressButton(userID uuid.UUID) {
+func (m *Manager) handlePressButton(action *PlayerAction, payload *wsMessage.PressButtonPayload) {
+	userID := action.UserID
 	logger.Infof(m.ctx, "[PRESS_BUTTON] Received from user: %s, game status: %s", userID, m.game.Status)
+	if m.handleFalseStart(action) {
+		return
+	}
 	if m.game.Status != domainGame.StatusButtonPress {
 		logger.Warnf(m.ctx, "[PRESS_BUTTON] Invalid game status: %s, expected: %s", m.game.Status, domainGame.StatusButtonPress)
+		m.reject(action, wsMessage.ErrorCodeInvalidPhase, "the button is not open")
 		return
 	}
 
 	p, ok := m.game.Players[userID]
 	if !ok {
 		logger.Warnf(m.ctx, "[PRESS_BUTTON] Player not found: %s", userID)
+		m.reject(action, wsMessage.ErrorCodePlayerNotFound, "player not found")
 		return
 	}
-	if !p.IsActive {
-		logger.Warnf(m.ctx, "[PRESS_BUTTON] Player is not active: %s", userID)
+	if !p.CanPressButton() {
+		logger.Warnf(m.ctx, "[PRESS_BUTTON] Player cannot press button: %s, role: %s, active: %v", userID, p.Role, p.IsActive)
+		m.reject(action, wsMessage.ErrorCodeNotAllowed, "you cannot press the button")
 		return
 	}
-	if p.Role == player.RoleHost {
-		logger.Warnf(m.ctx, "[PRESS_BUTTON] Host cannot press button: %s, role: %s", userID, p.Role)
+
+	logger.Infof(m.ctx, "[PRESS_BUTTON] Processing button press: user=%s, username=%s", userID, p.Username)
+
+	if m.buttonPress.IsLockedOut(userID) {
+		logger.Infof(m.ctx, "[PRESS_BUTTON] User %s is locked out after a false start", userID)
+		m.reject(action, wsMessage.ErrorCodeNotAllowed, "you are locked out after a false start")
 		return
 	}
-	
-	logger.Infof(m.ctx, "[PRESS_BUTTON] Processing button press: user=%s, username=%s", userID, p.Username)
 
 	rtt := m.hub.GetClientRTT(m.game.ID, userID)
 
-	if m.buttonPress.Press(userID, p.Username, rtt) {
-		if m.buttonPress.GetPressCount() == 1 {
-			go m.finishButtonPressCollection()
+	var timing *button.ClientTiming
+	if payload.ClientTime > 0 && p.ClockSynced {
+		timing = &button.ClientTiming{
+			PressedAt: time.UnixMilli(payload.ClientTime),
+			Offset:    p.ClockOffset,
 		}
 	}
+
+	entry, ok := m.buttonPress.PressWithClientTime(userID, p.Username, rtt, timing)
+	if !ok {
+		m.reject(action, wsMessage.ErrorCodeAlreadySubmitted, "button press was not accepted")
+		return
+	}
+	if entry.Suspicious {
+		logger.Warnf(m.ctx, "[PRESS_BUTTON] Implausible client timestamp from %s: client_time=%v, offset=%v, received=%v, counted as %v",
+			userID, entry.ClientTime, p.ClockOffset, entry.ReceivedAt, entry.AdjustedTime)
+	}
+
+	if m.buttonPress.GetPressCount() == 1 {
+		window := m.game.Settings.GetButtonWindow()
+		logger.Infof(m.ctx, "[PRESS_BUTTON] First press, collecting presses for %v", window)
+		m.schedule(tagButtonPressCollection, window, m.finishButtonPressCollection)
+	}
 }
 
-func (m *Manager) finishButtonPressCollection() {
-	logger.Infof(m.ctx, "[finishButtonPressCollection] Starting, waiting %v", ButtonPressCollectionWindow)
-	time.Sleep(ButtonPressCollectionWindow)
+func (m *Manager) handleFalseStart(action *PlayerAction) bool {
+	lockout := m.game.Settings.GetFalseStartLockout()
+	if lockout <= 0 || m.game.Status != domainGame.StatusQuestionShow {
+		return false
+	}
+	if m.game.CurrentQuestion == nil || m.game.CurrentQuestion.GetType() != pack.TypeNormal {
+		return false
+	}
+
+	p, ok := m.game.Players[action.UserID]
+	if !ok || !p.CanPressButton() {
+		return false
+	}
 
-	m.mu.Lock()
-	defer m.mu.Unlock()
+	if !m.buttonPress.RecordFalseStart(action.UserID, p.Username) {
+		return true
+	}
+
+	logger.Infof(m.ctx, "[PRESS_BUTTON] False start by %s (%s), locked out for %v once buttons open", action.UserID, p.Username, lockout)
+
+	msg := wsMessage.NewFalseStartMessage(action.UserID, lockout.Milliseconds())
+	m.hub.BroadcastToUser(m.game.ID, action.UserID, msg)
+	return true
+}
 
-	logger.Infof(m.ctx, "[finishButtonPressCollection] After sleep, game status: %s", m.game.Status)
+func (m *Manager) finishButtonPressCollection() {
+	logger.Infof(m.ctx, "[finishButtonPressCollection] Collection window closed, game status: %s", m.game.Status)
 	if m.game.Status != domainGame.StatusButtonPress {
 		logger.Warnf(m.ctx, "[finishButtonPressCollection] Game status changed, aborting: %s", m.game.Status)
 		return
 	}
+	if !m.buttonPress.HasPresses() {
+		logger.Infof(m.ctx, "[finishButtonPressCollection] All presses were withdrawn, keeping the button open")
+		return
+	}
 
 	m.buttonPress.Close()
 	winner := m.buttonPress.GetWinner()
@@ -318,241 +323,172 @@ func (m *Manager) finishButtonPressCollection() {
 	}
 
 	logger.Infof(m.ctx, "[finishButtonPressCollection] Winner: %s (%s), setting active player and transitioning to answer_judging immediately", winner.UserID, winner.Username)
+	m.pressWindowLeft = m.timer.RemainingDuration()
 	m.timer.Stop()
 	m.game.SetActivePlayer(winner.UserID)
 
-	m.game.UpdateStatus(domainGame.StatusAnswerJudging)
+	if !m.transition(domainGame.StatusAnswerJudging) {
+		return
+	}
+	m.broadcastButtonPressed(winner)
 	logger.Infof(m.ctx, "[finishButtonPressCollection] Status changed to: %s, activePlayer: %v, broadcasting state", m.game.Status, m.game.ActivePlayer)
 	m.BroadcastState()
 	m.timer.Start(time.Duration(m.game.Settings.TimeForAnswer) * time.Second)
 	logger.Infof(m.ctx, "[finishButtonPressCollection] Timer started for %d seconds (for answer timeout)", m.game.Settings.TimeForAnswer)
 }
 
-func (m *Manager) handleSubmitAnswer(action *PlayerAction) {
+func (m *Manager) handleSubmitAnswer(action *PlayerAction, payload *wsMessage.SubmitAnswerPayload) {
 	if m.game.Status != domainGame.StatusAnswering {
+		m.reject(action, wsMessage.ErrorCodeInvalidPhase, "answers are not being accepted")
 		return
 	}
 
 	if m.game.ActivePlayer == nil || *m.game.ActivePlayer != action.UserID {
+		m.reject(action, wsMessage.ErrorCodeNotYourTurn, "another player is answering")
 		return
 	}
 
-	answerStr, ok := action.Message.GetPayload()["answer"].(string)
-	if !ok {
-		return
-	}
-
+	answerStr := payload.Answer
 	p := m.game.Players[action.UserID]
 	m.timer.Stop()
 
-	correct := m.game.CurrentQuestion.ValidateAnswer(answerStr)
+	result := m.matchAnswer(answerStr)
+	correct := result.Matched
+	m.answerMatch = &domainGame.AnswerMatch{
+		UserID:     action.UserID,
+		Answer:     answerStr,
+		Matched:    result.Matched,
+		Confidence: result.Confidence,
+		Borderline: result.IsBorderline(),
+	}
 
-	questionPrice := m.game.CurrentQuestion.Price
+	m.scoreAnswer(p, correct)
 	if correct {
-		p.AddScore(questionPrice)
-	} else {
-		p.SubtractScore(questionPrice)
+		m.recordCorrectAnswer(action.UserID)
 	}
 
 	m.transitionToAnswerJudging()
 }
 
-func (m *Manager) handleJudgeAnswer(action *PlayerAction) {
+func (m *Manager) handleJudgeAnswer(action *PlayerAction, payload *wsMessage.JudgeAnswerPayload) {
 	hostPlayer := m.game.Players[action.UserID]
 	if hostPlayer.Role != player.RoleHost {
+		m.reject(action, wsMessage.ErrorCodeNotHost, "only the host can judge answers")
 		return
 	}
 
 	if m.game.Status != domainGame.StatusAnswerJudging {
+		m.reject(action, wsMessage.ErrorCodeInvalidPhase, "no answer is being judged")
 		return
 	}
 
-	correct, ok := action.Message.GetPayload()["correct"].(bool)
-	if !ok {
+	correct, answeringUserID := payload.Correct, payload.UserID
+	if m.game.ActivePlayer == nil || *m.game.ActivePlayer != answeringUserID {
+		m.reject(action, wsMessage.ErrorCodeNotYourTurn, "this player is not answering")
 		return
 	}
 
-	answeringUserIDStr, ok := action.Message.GetPayload()["user_id"].(string)
+	p, ok := m.game.Players[answeringUserID]
 	if !ok {
+		m.reject(action, wsMessage.ErrorCodePlayerNotFound, "player not found")
 		return
 	}
-
-	answeringUserID, err := uuid.Parse(answeringUserIDStr)
-	if err != nil {
-		return
-	}
-
-	p := m.game.Players[answeringUserID]
-	questionPrice := m.game.CurrentQuestion.Price
-
+	delta := m.scoreAnswer(p, correct)
+	m.broadcastAnswerResult(p, correct, delta)
 	if correct {
-		p.AddScore(questionPrice)
-	} else {
-		p.SubtractScore(questionPrice)
-	}
-
-	m.continueGame()
-}
-
-func (m *Manager) handleMediaLoadProgress(action *PlayerAction) {
-	loaded, _ := action.Message.GetPayload()["loaded"].(float64)
-	total, _ := action.Message.GetPayload()["total"].(float64)
-	bytesLoaded, _ := action.Message.GetPayload()["bytes_loaded"].(float64)
-	percent, _ := action.Message.GetPayload()["percent"].(float64)
-
-	m.mediaTracker.UpdateProgress(
-		action.UserID,
-		int(loaded),
-		int(total),
-		int64(bytesLoaded),
-		int(percent),
-	)
-}
-
-func (m *Manager) handleMediaLoadComplete(action *PlayerAction) {
-	loadedCount, _ := action.Message.GetPayload()["loaded_count"].(float64)
-	m.mediaTracker.MarkComplete(action.UserID, int(loadedCount))
-}
-
-func (m *Manager) handleTransferSecret(action *PlayerAction) {
-	logger.Infof(m.ctx, "[TRANSFER_SECRET] Received from user: %s, game status: %s", action.UserID, m.game.Status)
-	if m.game.Status != domainGame.StatusSecretTransfer {
-		logger.Warnf(m.ctx, "[TRANSFER_SECRET] Invalid game status: %s, expected: %s", m.game.Status, domainGame.StatusSecretTransfer)
-		return
-	}
-
-	hostPlayer := m.game.Players[action.UserID]
-	if hostPlayer.Role != player.RoleHost {
-		logger.Warnf(m.ctx, "[TRANSFER_SECRET] User is not host: %s, role: %s", action.UserID, hostPlayer.Role)
-		return
-	}
-
-	targetUserIDStr, ok := action.Message.GetPayload()["target_user_id"].(string)
-	if !ok {
+		m.recordCorrectAnswer(answeringUserID)
+		m.continueGame()
 		return
 	}
 
-	targetUserID, err := uuid.Parse(targetUserIDStr)
-	if err != nil {
-		return
-	}
-
-	targetPlayer, exists := m.game.Players[targetUserID]
-	if !exists || targetPlayer.Role == player.RoleHost {
-		return
-	}
-
-	m.transferSecretToPlayer(action.UserID, targetUserID)
+	m.reopenButtonsOrContinue(answeringUserID)
 }
 
-func (m *Manager) transferSecretToPlayer(fromUserID, toUserID uuid.UUID) {
-	m.timer.Stop()
-	m.game.SetActivePlayer(toUserID)
-	m.secretTarget = &toUserID
-
-	m.game.UpdateStatus(domainGame.StatusQuestionShow)
-	m.BroadcastState()
-
-	if m.game.CurrentQuestion.HasMedia() {
-		m.sendStartMedia(m.game.CurrentQuestion)
+func (m *Manager) reopenButtonsOrContinue(wrongUserID uuid.UUID) {
+	if !m.game.Settings.ReopenButtons || m.game.CurrentQuestion == nil || m.game.CurrentQuestion.GetType() != pack.TypeNormal {
+		m.continueGame()
+		return
 	}
 
-	readTime := QuestionReadDuration
-	if m.game.CurrentQuestion.MediaDurationMs > 0 {
-		readTime += time.Duration(m.game.CurrentQuestion.MediaDurationMs) * time.Millisecond
-	}
-	m.timer.Start(readTime)
-}
+	m.buttonPress.Exclude(wrongUserID)
 
-func (m *Manager) handlePlaceStake(action *PlayerAction) {
-	logger.Infof(m.ctx, "[PLACE_STAKE] Received from user: %s, game status: %s", action.UserID, m.game.Status)
-	if m.game.Status != domainGame.StatusStakeBetting {
-		logger.Warnf(m.ctx, "[PLACE_STAKE] Invalid game status: %s, expected: %s", m.game.Status, domainGame.StatusStakeBetting)
-		return
+	remaining := 0
+	for userID, p := range m.game.Players {
+		if p.CanPressButton() && !m.buttonPress.IsExcluded(userID) {
+			remaining++
+		}
 	}
-
-	if m.game.ActivePlayer == nil || *m.game.ActivePlayer != action.UserID {
-		logger.Warnf(m.ctx, "[PLACE_STAKE] User is not active player: %s, active: %v", action.UserID, m.game.ActivePlayer)
+	if remaining == 0 {
+		logger.Infof(m.ctx, "[reopenButtonsOrContinue] Everyone has tried, finishing question")
+		m.continueGame()
 		return
 	}
-
-	amount, ok := action.Message.GetPayload()["amount"].(float64)
-	if !ok {
+	if m.pressWindowLeft <= 0 {
+		logger.Infof(m.ctx, "[reopenButtonsOrContinue] Press window is over, finishing question")
+		m.continueGame()
 		return
 	}
 
-	allIn, _ := action.Message.GetPayload()["all_in"].(bool)
-
-	m.placeStake(action.UserID, int(amount), allIn)
-}
-
-func (m *Manager) placeStake(userID uuid.UUID, amount int, allIn bool) {
 	m.timer.Stop()
-
-	p := m.game.Players[userID]
-
-	if m.stakeInfo == nil {
+	m.answerMatch = nil
+	m.game.ClearActivePlayer()
+	if !m.transition(domainGame.StatusButtonPress) {
 		return
 	}
+	m.buttonPress.Reopen()
 
-	if amount < m.stakeInfo.MinBet {
-		amount = m.stakeInfo.MinBet
-	}
-	if amount > m.stakeInfo.MaxBet {
-		amount = m.stakeInfo.MaxBet
-	}
-	if allIn {
-		amount = p.Score
-		if amount < m.stakeInfo.MinBet {
-			amount = m.stakeInfo.MinBet
-		}
-	}
-
-	m.stakeInfo.CurrentBet = amount
-	m.stakeInfo.IsAllIn = allIn
-	m.game.CurrentQuestion.Price = amount
-
-	m.game.UpdateStatus(domainGame.StatusQuestionShow)
+	logger.Infof(m.ctx, "[reopenButtonsOrContinue] Reopening buttons for %d players, locked out: %v", remaining, m.buttonPress.Excluded())
+	m.timer.Start(m.pressWindowLeft)
 	m.BroadcastState()
+}
 
-	if m.game.CurrentQuestion.HasMedia() {
-		m.sendStartMedia(m.game.CurrentQuestion)
-	}
+func (m *Manager) handleMediaLoadProgress(action *PlayerAction, payload *wsMessage.MediaLoadProgressPayload) {
+	m.mediaTracker.UpdateProgress(
+		action.UserID,
+		payload.Loaded,
+		payload.Total,
+		payload.BytesLoaded,
+		payload.Percent,
+	)
+}
 
-	readTime := QuestionReadDuration
-	if m.game.CurrentQuestion.MediaDurationMs > 0 {
-		readTime += time.Duration(m.game.CurrentQuestion.MediaDurationMs) * time.Millisecond
-	}
-	m.timer.Start(readTime)
+func (m *Manager) handleMediaLoadComplete(action *PlayerAction, payload *wsMessage.MediaLoadCompletePayload) {
+	m.mediaTracker.MarkComplete(action.UserID, payload.LoadedCount)
 }
 
-func (m *Manager) handleSubmitForAllAnswer(action *PlayerAction) {
+func (m *Manager) handleSubmitForAllAnswer(action *PlayerAction, payload *wsMessage.SubmitForAllAnswerPayload) {
 	if m.game.Status != domainGame.StatusForAllAnswering {
+		m.reject(action, wsMessage.ErrorCodeInvalidPhase, "answers are not being accepted")
 		return
 	}
 
 	p, ok := m.game.Players[action.UserID]
-	if !ok || !p.IsActive || p.Role == player.RoleHost {
+	if !ok || !p.CanAnswer() {
+		m.reject(action, wsMessage.ErrorCodeNotAllowed, "you cannot answer this question")
 		return
 	}
 
-	answerStr, ok := action.Message.GetPayload()["answer"].(string)
-	if !ok {
+	if !m.forAllCollector.SubmitAnswer(action.UserID, p.Username, payload.Answer) {
+		m.reject(action, wsMessage.ErrorCodeAlreadySubmitted, "answer already submitted")
 		return
 	}
 
-	if m.forAllCollector.SubmitAnswer(action.UserID, p.Username, answerStr) {
-		expectedAnswers := 0
-		for _, p := range m.game.Players {
-			if p.Role != player.RoleHost && p.IsActive {
-				expectedAnswers++
-			}
+	expectedAnswers := 0
+	for _, p := range m.game.Players {
+		if p.CanAnswer() {
+			expectedAnswers++
 		}
+	}
 
-		if m.forAllCollector.GetAnswerCount() >= expectedAnswers {
-			m.timer.Stop()
-			m.finishForAllQuestion()
-		}
+	if m.forAllCollector.GetAnswerCount() >= expectedAnswers {
+		m.timer.Stop()
+		m.finishForAllQuestion()
 	}
 }
 
+func (m *Manager) matchAnswer(userAnswer string) answer.MatchResult {
+	if m.game.CurrentQuestion == nil {
+		return answer.MatchResult{Confidence: answer.NoConfidence}
+	}
+	return m.matcher.Match(userAnswer, m.game.CurrentQuestion.AcceptedAnswers())
+}
@@ -11,7 +11,7 @@ import (
 )
 
 func (m *Manager) startGame() {
-	now := time.Now()
+	now := m.clock.Now()
 	m.game.StartedAt = &now
 	m.game.CurrentRound = InitialRoundNumber
 
@@ -21,10 +21,12 @@ func (m *Manager) startGame() {
 }
 
 func (m *Manager) showRoundsOverview() {
-	m.game.UpdateStatus(domainGame.StatusRoundsOverview)
+	if !m.transition(domainGame.StatusRoundsOverview) {
+		return
+	}
 	m.BroadcastState()
 
-	m.timer.Start(RoundsOverviewDuration)
+	m.timer.Start(m.game.Settings.GetRoundsOverviewDuration())
 }
 
 func (m *Manager) startRound(roundNumber int) {
@@ -34,7 +36,9 @@ func (m *Manager) startRound(roundNumber int) {
 	}
 
 	m.game.CurrentRound = roundNumber
-	m.game.UpdateStatus(domainGame.StatusRoundStart)
+	if !m.transition(domainGame.StatusRoundStart) {
+		return
+	}
 
 	evt := event.New(m.game.ID, event.TypeRoundStarted).WithRound(roundNumber)
 	m.eventLogger.LogEvent(context.Background(), evt)
@@ -53,37 +57,42 @@ func (m *Manager) startRound(roundNumber int) {
 	}
 
 	m.BroadcastState()
-	m.timer.Start(RoundIntroDuration)
+	m.timer.Start(m.game.Settings.GetRoundIntroDuration())
 }
 
 func (m *Manager) endRound() {
-	m.game.UpdateStatus(domainGame.StatusRoundEnd)
+	if !m.transition(domainGame.StatusRoundEnd) {
+		return
+	}
 
 	evt := event.New(m.game.ID, event.TypeRoundFinished).WithRound(m.game.CurrentRound)
 	m.eventLogger.LogEvent(context.Background(), evt)
 
-	m.BroadcastState()
-
 	currentRound := m.game.CurrentRound
 	totalRounds := m.pack.TotalRounds()
 
-	go func() {
-		time.Sleep(RoundEndDelay)
-
-		m.mu.Lock()
-		defer m.mu.Unlock()
+	var nextRound *int
+	if currentRound < totalRounds {
+		next := currentRound + 1
+		nextRound = &next
+	}
+	m.broadcastRoundComplete(currentRound, nextRound)
+	m.BroadcastState()
 
+	m.schedule(tagRoundEnd, m.game.Settings.GetRoundEndDuration(), func() {
 		if currentRound < totalRounds {
 			m.startRound(currentRound + 1)
 		} else {
 			m.endGame()
 		}
-	}()
+	})
 }
 
 func (m *Manager) endGame() {
-	m.game.UpdateStatus(domainGame.StatusGameEnd)
-	now := time.Now()
+	if !m.transition(domainGame.StatusGameEnd) {
+		return
+	}
+	now := m.clock.Now()
 	m.game.FinishedAt = &now
 
 	m.game.Winners = m.calculateWinners()
@@ -91,6 +100,7 @@ func (m *Manager) endGame() {
 
 	m.logEvent(event.TypeGameFinished)
 
+	m.broadcastGameComplete()
 	m.BroadcastState()
 
 	if m.timerTicker != nil {
@@ -99,21 +109,21 @@ func (m *Manager) endGame() {
 }
 
 func (m *Manager) transitionToQuestionSelect() {
-	host, err := m.game.GetHost()
-	if err != nil {
+	m.startSelectionTurn()
+
+	if !m.transition(domainGame.StatusQuestionSelect) {
 		return
 	}
-	m.game.SetActivePlayer(host.UserID)
-
-	m.game.UpdateStatus(domainGame.StatusQuestionSelect)
 
 	m.timer.Start(time.Duration(m.game.Settings.TimeForChoice) * time.Second)
 	m.BroadcastState()
 }
 
 func (m *Manager) transitionToButtonPress() {
-	m.game.UpdateStatus(domainGame.StatusButtonPress)
-	m.buttonPress.Reset()
+	if !m.transition(domainGame.StatusButtonPress) {
+		return
+	}
+	m.buttonPress.Open(m.game.Settings.GetFalseStartLockout())
 
 	m.timer.Start(time.Duration(m.game.Settings.TimeForAnswer) * time.Second)
 	m.BroadcastState()
@@ -121,13 +131,17 @@ func (m *Manager) transitionToButtonPress() {
 
 func (m *Manager) transitionToAnswerJudging() {
 	logger.Infof(m.ctx, "[transitionToAnswerJudging] Transitioning from status: %s to answer_judging, activePlayer: %v", m.game.Status, m.game.ActivePlayer)
-	m.game.UpdateStatus(domainGame.StatusAnswerJudging)
+	if !m.transition(domainGame.StatusAnswerJudging) {
+		return
+	}
 	m.BroadcastState()
-	m.timer.Start(AnswerJudgingDuration)
-	logger.Infof(m.ctx, "[transitionToAnswerJudging] Status changed to: %s, timer started for %v", m.game.Status, AnswerJudgingDuration)
+	m.timer.Start(m.game.Settings.GetJudgingDuration())
+	logger.Infof(m.ctx, "[transitionToAnswerJudging] Status changed to: %s, timer started for %v", m.game.Status, m.game.Settings.GetJudgingDuration())
 }
 
 func (m *Manager) transitionFromQuestionShow() {
+	m.finishReading()
+
 	if m.game.CurrentQuestion == nil {
 		m.transitionToButtonPress()
 		return
@@ -141,11 +155,38 @@ func (m *Manager) transitionFromQuestionShow() {
 	}
 }
 
-func (m *Manager) continueGame() {
+func (m *Manager) transition(status domainGame.Status) bool {
+	if err := m.game.TransitionTo(status); err != nil {
+		logger.Errorf(m.ctx, "[transition] Game %s: %v", m.game.ID, err)
+		return false
+	}
+	return true
+}
+
+func (m *Manager) clearQuestion() {
 	m.game.ClearCurrentQuestion()
 	m.stakeInfo = nil
 	m.secretTarget = nil
+	m.secretInfo = nil
+	m.answerMatch = nil
+	m.forAllResults = nil
+	m.reading = nil
+	m.answerReveal = nil
 	m.forAllCollector.Reset()
+	m.scheduler.Cancel(tagButtonPressCollection, tagForAllRead, tagReadProgress)
+}
+
+func (m *Manager) continueGame() {
+	if m.game.CurrentQuestion != nil && m.game.Status != domainGame.StatusAnswerReveal {
+		m.revealAnswer()
+		return
+	}
+
+	m.nextQuestion()
+}
+
+func (m *Manager) nextQuestion() {
+	m.clearQuestion()
 
 	round := m.pack.GetRound(m.game.CurrentRound)
 	if round.IsComplete() {
@@ -153,10 +194,11 @@ func (m *Manager) continueGame() {
 		return
 	}
 
-	host, _ := m.game.GetHost()
-	m.game.SetActivePlayer(host.UserID)
+	m.startSelectionTurn()
 
-	m.game.UpdateStatus(domainGame.StatusQuestionSelect)
+	if !m.transition(domainGame.StatusQuestionSelect) {
+		return
+	}
 	m.BroadcastState()
 
 	m.timer.Start(time.Duration(m.game.Settings.TimeForChoice) * time.Second)
@@ -8,11 +8,14 @@ import (
 	"github.com/google/uuid"
 	"sigame/game/internal/core/answer"
 	"sigame/game/internal/core/button"
+	"sigame/game/internal/core/clock"
 	"sigame/game/internal/core/media"
+	"sigame/game/internal/core/scoring"
 	"sigame/game/internal/core/timer"
 	"sigame/game/internal/domain/event"
 	domainGame "sigame/game/internal/domain/game"
 	"sigame/game/internal/domain/pack"
+	"sigame/game/internal/domain/player"
 	"sigame/game/internal/infrastructure/logger"
 	"sigame/game/internal/port"
 	wsMessage "sigame/game/internal/transport/ws/message"
@@ -26,16 +29,29 @@ type Manager struct {
 	cancel          context.CancelFunc
 	actionChan      chan *PlayerAction
 	timer           *timer.Timer
+	scheduler       *scheduler
 	timerTicker      *time.Ticker
 	buttonPress     *button.Press
 	mediaTracker    *media.MediaTracker
 	forAllCollector *answer.ForAllCollector
+	forAllResults   []domainGame.ForAllResult
+	matcher         answer.AnswerMatcher
+	scorer          *scoring.Service
+	answerMatch     *domainGame.AnswerMatch
+	pressWindowLeft time.Duration
 	stakeInfo       *domainGame.StakeInfo
 	secretTarget    *uuid.UUID
+	secretInfo      *domainGame.SecretInfo
+	reading         *readingProgress
+	lastCorrect     *uuid.UUID
+	selectionTurn   int
+	scoresBefore    map[uuid.UUID]int
+	answerReveal    *domainGame.AnswerReveal
 	mu              sync.RWMutex
 	eventLogger     port.EventLogger
 	gameRepository  port.GameRepository
 	gameCache       port.GameCache
+	clock           clock.Clock
 }
 
 type PlayerAction struct {
@@ -44,16 +60,19 @@ type PlayerAction struct {
 }
 
 type ClientMessage interface {
+	GetID() string
 	GetType() string
-	GetPayload() map[string]interface{}
+	DecodePayload(v interface{}) error
 }
 
 type Hub interface {
-	Broadcast(gameID uuid.UUID, message []byte)
+	Broadcast(gameID uuid.UUID, msg *wsMessage.ServerMessage)
+	BroadcastPersonalized(gameID uuid.UUID, render func(userID uuid.UUID) *wsMessage.ServerMessage)
+	BroadcastToUser(gameID, userID uuid.UUID, msg *wsMessage.ServerMessage)
 	GetClientRTT(gameID, userID uuid.UUID) time.Duration
 }
 
-func New(game *domainGame.Game, pack *pack.Pack, hub Hub, eventLogger port.EventLogger, gameRepository port.GameRepository, gameCache port.GameCache) *Manager {
+func New(game *domainGame.Game, pack *pack.Pack, hub Hub, eventLogger port.EventLogger, gameRepository port.GameRepository, gameCache port.GameCache, clk clock.Clock) *Manager {
 	ctx, cancel := context.WithCancel(context.Background())
 
 	return &Manager{
@@ -63,13 +82,17 @@ func New(game *domainGame.Game, pack *pack.Pack, hub Hub, eventLogger port.Event
 		ctx:             ctx,
 		cancel:          cancel,
 		actionChan:      make(chan *PlayerAction, ManagerActionChannelBuffer),
-		timer:           timer.New(),
-		buttonPress:     button.New(),
+		timer:           timer.New(clk),
+		scheduler:       newScheduler(clk),
+		buttonPress:     button.New(clk),
 		mediaTracker:    media.NewMediaTracker(InitialRoundNumber),
-		forAllCollector: answer.NewForAllCollector(),
+		forAllCollector: answer.NewForAllCollector(clk),
+		matcher:         answer.NewMatcher(game.Settings.GetAnswerMatch(), game.Settings.GetMatchThreshold()),
+		scorer:          scoring.NewService(game.Settings.Scoring),
 		eventLogger:     eventLogger,
 		gameRepository:  gameRepository,
 		gameCache:       gameCache,
+		clock:           clk,
 	}
 }
 
@@ -86,6 +109,7 @@ func (m *Manager) Start() {
 func (m *Manager) Stop() {
 	m.cancel()
 	m.timer.Stop()
+	m.scheduler.CancelAll()
 	if m.timerTicker != nil {
 		m.timerTicker.Stop()
 	}
@@ -128,6 +152,16 @@ func (m *Manager) run() {
 				m.handleTimeout()
 			}()
 
+		case ev := <-m.scheduler.C:
+			func() {
+				defer func() {
+					if r := recover(); r != nil {
+						logger.Errorf(m.ctx, "Panic handling scheduled event: %v", r)
+					}
+				}()
+				m.handleScheduledEvent(ev)
+			}()
+
 		case <-m.timerTicker.C:
 			func() {
 				defer func() {
@@ -147,7 +181,7 @@ func (m *Manager) HandleClientMessage(userID uuid.UUID, msg interface{}) {
 		logger.Warnf(m.ctx, "[HandleClientMessage] Invalid message type: %T, expected: *wsMessage.ClientMessage", msg)
 		return
 	}
-	logger.Infof(m.ctx, "[HandleClientMessage] Received: type=%s, user_id=%s, payload=%v", clientMsg.GetType(), userID, clientMsg.GetPayload())
+	logger.Infof(m.ctx, "[HandleClientMessage] Received: type=%s, user_id=%s, payload=%s", clientMsg.GetType(), userID, clientMsg.Payload)
 	select {
 	case m.actionChan <- &PlayerAction{UserID: userID, Message: clientMsg}:
 	case <-m.ctx.Done():
@@ -158,26 +192,49 @@ func (m *Manager) handlePlayerAction(action *PlayerAction) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
-	switch action.Message.GetType() {
-	case "SELECT_QUESTION":
-		m.handleSelectQuestion(action)
-	case "PRESS_BUTTON":
-		m.handlePressButton(action.UserID)
-	case "SUBMIT_ANSWER":
-		m.handleSubmitAnswer(action)
-	case "JUDGE_ANSWER":
-		m.handleJudgeAnswer(action)
-	case "MEDIA_LOAD_PROGRESS":
-		m.handleMediaLoadProgress(action)
-	case "MEDIA_LOAD_COMPLETE":
-		m.handleMediaLoadComplete(action)
-	case "TRANSFER_SECRET":
-		m.handleTransferSecret(action)
-	case "PLACE_STAKE":
-		m.handlePlaceStake(action)
-	case "SUBMIT_FOR_ALL_ANSWER":
-		m.handleSubmitForAllAnswer(action)
+	if action.Message.GetType() == "PONG" {
+		return
+	}
+
+	p, ok := m.game.Players[action.UserID]
+	if !ok {
+		logger.Warnf(m.ctx, "[handlePlayerAction] Rejected %s from non-player %s (spectator=%v)", action.Message.GetType(), action.UserID, m.game.IsSpectator(action.UserID))
+		m.reject(action, wsMessage.ErrorCodeNotAllowed, "spectators cannot take game actions")
+		return
 	}
+	if !p.IsActive {
+		logger.Warnf(m.ctx, "[handlePlayerAction] Rejected %s from inactive player %s", action.Message.GetType(), action.UserID)
+		m.reject(action, wsMessage.ErrorCodeNotAllowed, "you are no longer in this game")
+		return
+	}
+
+	if m.game.Paused && !allowedWhilePaused(action.Message.GetType()) {
+		logger.Warnf(m.ctx, "[handlePlayerAction] Ignored %s from %s, game is paused", action.Message.GetType(), action.UserID)
+		m.reject(action, wsMessage.ErrorCodeGamePaused, "the game is paused")
+		return
+	}
+
+	m.dispatchAction(action)
+}
+
+func (m *Manager) AdmitUser(userID uuid.UUID, username string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.game.Players[userID]; ok || m.game.IsSpectator(userID) {
+		return nil
+	}
+
+	if !m.game.Status.IsPlaying() || m.game.Status == domainGame.StatusGameEnd {
+		return domainGame.ErrGameNotRunning
+	}
+
+	if err := m.game.AddSpectator(player.New(userID, username, "", player.RoleSpectator)); err != nil {
+		return err
+	}
+
+	logger.Infof(m.ctx, "[AdmitUser] Spectator %s (%s) joined game %s", userID, username, m.game.ID)
+	return nil
 }
 
 func (m *Manager) SetPlayerConnected(userID uuid.UUID, connected bool) {
@@ -187,6 +244,10 @@ func (m *Manager) SetPlayerConnected(userID uuid.UUID, connected bool) {
 		player.SetConnected(connected)
 		playerFound = true
 		logger.Infof(m.ctx, "[SetPlayerConnected] Player %s (%s) connected=%v", userID, player.Username, connected)
+	} else if spectator, err := m.game.GetSpectator(userID); err == nil {
+		spectator.SetConnected(connected)
+		playerFound = true
+		logger.Infof(m.ctx, "[SetPlayerConnected] Spectator %s (%s) connected=%v", userID, spectator.Username, connected)
 	}
 	m.mu.Unlock()
 
@@ -195,6 +256,26 @@ func (m *Manager) SetPlayerConnected(userID uuid.UUID, connected bool) {
 	}
 }
 
+func (m *Manager) SetPlayerRTT(userID uuid.UUID, rtt time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if p, err := m.game.GetPlayer(userID); err == nil {
+		p.SetRTT(rtt)
+	} else if spectator, err := m.game.GetSpectator(userID); err == nil {
+		spectator.SetRTT(rtt)
+	}
+}
+
+func (m *Manager) SetPlayerClockOffset(userID uuid.UUID, offset time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if p, err := m.game.GetPlayer(userID); err == nil {
+		p.SetClockOffset(offset)
+	}
+}
+
 func (m *Manager) SendStateToClient(client interface{}) {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
@@ -212,11 +293,10 @@ func (m *Manager) logEvent(eventType event.Type) *event.Event {
 func (m *Manager) saveGameState() {
 	ctx := context.Background()
 
-	var gameCopy *domainGame.Game
-	m.mu.RLock()
-	m.game.UpdatedAt = time.Now()
-	gameCopy = m.game
-	m.mu.RUnlock()
+	m.mu.Lock()
+	m.game.UpdatedAt = m.clock.Now()
+	gameCopy := m.game.Clone()
+	m.mu.Unlock()
 
 	go func() {
 		m.saveGameStateWithRetry(ctx, gameCopy, MaxSaveRetries, SaveRetryDelay)
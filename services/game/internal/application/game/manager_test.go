@@ -2,24 +2,37 @@ package game
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"sigame/game/internal/core/clock"
 	domainGame "sigame/game/internal/domain/game"
 	"sigame/game/internal/domain/event"
 	"sigame/game/internal/domain/pack"
 	"sigame/game/internal/domain/player"
+	wsMessage "sigame/game/internal/transport/ws/message"
 )
 
 type MockHub struct {
 	mock.Mock
 }
 
-func (m *MockHub) Broadcast(gameID uuid.UUID, message []byte) {
-	m.Called(gameID, message)
+func (m *MockHub) Broadcast(gameID uuid.UUID, msg *wsMessage.ServerMessage) {
+	m.Called(gameID, msg)
+}
+
+func (m *MockHub) BroadcastPersonalized(gameID uuid.UUID, render func(userID uuid.UUID) *wsMessage.ServerMessage) {
+	m.Called(gameID, render)
+}
+
+func (m *MockHub) BroadcastToUser(gameID, userID uuid.UUID, msg *wsMessage.ServerMessage) {
+	m.Called(gameID, userID, msg)
 }
 
 func (m *MockHub) GetClientRTT(gameID, userID uuid.UUID) time.Duration {
@@ -97,16 +110,37 @@ func (m *MockGameCache) GetActiveGames(ctx context.Context, limit int64) ([]uuid
 }
 
 type MockClientMessage struct {
+	id       string
 	msgType  string
 	payload  map[string]interface{}
 }
 
+func (m *MockClientMessage) GetID() string {
+	return m.id
+}
+
 func (m *MockClientMessage) GetType() string {
 	return m.msgType
 }
 
-func (m *MockClientMessage) GetPayload() map[string]interface{} {
-	return m.payload
+func (m *MockClientMessage) DecodePayload(v interface{}) error {
+	data, err := json.Marshal(m.payload)
+	if err != nil {
+		return err
+	}
+	return wsMessage.DecodePayload(data, v)
+}
+
+func newPermissiveRepository() *MockGameRepository {
+	repo := new(MockGameRepository)
+	repo.On("UpdateGameSession", mock.Anything, mock.Anything).Return(nil).Maybe()
+	return repo
+}
+
+func newPermissiveCache() *MockGameCache {
+	cache := new(MockGameCache)
+	cache.On("SaveGameState", mock.Anything, mock.Anything).Return(nil).Maybe()
+	return cache
 }
 
 func createTestGame() *domainGame.Game {
@@ -146,6 +180,7 @@ func TestManager_Start(t *testing.T) {
 	testPack := createTestPack()
 	mockHub := new(MockHub)
 	mockHub.On("Broadcast", mock.Anything, mock.Anything).Return()
+	mockHub.On("BroadcastPersonalized", mock.Anything, mock.Anything).Return()
 	mockHub.On("GetClientRTT", mock.Anything, mock.Anything).Return(time.Duration(0)).Maybe()
 	mockLogger := new(MockEventLogger)
 	mockLogger.On("LogEvent", mock.Anything, mock.Anything).Return(nil)
@@ -154,7 +189,7 @@ func TestManager_Start(t *testing.T) {
 	mockCache.On("SaveGameState", mock.Anything, mock.Anything).Return(nil).Maybe()
 	mockRepo.On("UpdateGameSession", mock.Anything, mock.Anything).Return(nil).Maybe()
 
-	manager := New(game, testPack, mockHub, mockLogger, mockRepo, mockCache)
+	manager := New(game, testPack, mockHub, mockLogger, mockRepo, mockCache, clock.Real())
 
 	manager.Start()
 
@@ -175,6 +210,7 @@ func TestManager_Stop(t *testing.T) {
 	testPack := createTestPack()
 	mockHub := new(MockHub)
 	mockHub.On("Broadcast", mock.Anything, mock.Anything).Return()
+	mockHub.On("BroadcastPersonalized", mock.Anything, mock.Anything).Return()
 	mockHub.On("GetClientRTT", mock.Anything, mock.Anything).Return(time.Duration(0)).Maybe()
 	mockLogger := new(MockEventLogger)
 	mockLogger.On("LogEvent", mock.Anything, mock.Anything).Return(nil)
@@ -183,7 +219,7 @@ func TestManager_Stop(t *testing.T) {
 	mockCache.On("SaveGameState", mock.Anything, mock.Anything).Return(nil).Maybe()
 	mockRepo.On("UpdateGameSession", mock.Anything, mock.Anything).Return(nil).Maybe()
 
-	manager := New(game, testPack, mockHub, mockLogger, mockRepo, mockCache)
+	manager := New(game, testPack, mockHub, mockLogger, mockRepo, mockCache, clock.Real())
 	manager.Start()
 
 	time.Sleep(100 * time.Millisecond)
@@ -207,6 +243,7 @@ func TestManager_HandleClientMessage(t *testing.T) {
 	testPack := createTestPack()
 	mockHub := new(MockHub)
 	mockHub.On("Broadcast", mock.Anything, mock.Anything).Return()
+	mockHub.On("BroadcastPersonalized", mock.Anything, mock.Anything).Return()
 	mockHub.On("GetClientRTT", mock.Anything, mock.Anything).Return(time.Duration(0)).Maybe()
 	mockLogger := new(MockEventLogger)
 	mockLogger.On("LogEvent", mock.Anything, mock.Anything).Return(nil)
@@ -215,7 +252,7 @@ func TestManager_HandleClientMessage(t *testing.T) {
 	mockCache.On("SaveGameState", mock.Anything, mock.Anything).Return(nil).Maybe()
 	mockRepo.On("UpdateGameSession", mock.Anything, mock.Anything).Return(nil).Maybe()
 
-	manager := New(game, testPack, mockHub, mockLogger, mockRepo, mockCache)
+	manager := New(game, testPack, mockHub, mockLogger, mockRepo, mockCache, clock.Real())
 	manager.Start()
 
 	userID := uuid.New()
@@ -236,14 +273,14 @@ func TestManager_SetPlayerConnected(t *testing.T) {
 	game := createTestGame()
 	testPack := createTestPack()
 	mockHub := new(MockHub)
-	mockHub.On("Broadcast", mock.Anything, mock.Anything).Return()
+	mockHub.On("BroadcastPersonalized", mock.Anything, mock.Anything).Return()
 	mockLogger := new(MockEventLogger)
 	mockRepo := new(MockGameRepository)
 	mockCache := new(MockGameCache)
 	mockCache.On("SaveGameState", mock.Anything, mock.Anything).Return(nil).Maybe()
 	mockRepo.On("UpdateGameSession", mock.Anything, mock.Anything).Return(nil).Maybe()
 
-	manager := New(game, testPack, mockHub, mockLogger, mockRepo, mockCache)
+	manager := New(game, testPack, mockHub, mockLogger, mockRepo, mockCache, clock.Real())
 
 	userID := uuid.New()
 	for uid := range game.Players {
@@ -271,6 +308,7 @@ func TestManager_HandleClientMessage_InvalidMessage(t *testing.T) {
 	testPack := createTestPack()
 	mockHub := new(MockHub)
 	mockHub.On("Broadcast", mock.Anything, mock.Anything).Return()
+	mockHub.On("BroadcastPersonalized", mock.Anything, mock.Anything).Return()
 	mockHub.On("GetClientRTT", mock.Anything, mock.Anything).Return(time.Duration(0)).Maybe()
 	mockLogger := new(MockEventLogger)
 	mockLogger.On("LogEvent", mock.Anything, mock.Anything).Return(nil)
@@ -279,7 +317,7 @@ func TestManager_HandleClientMessage_InvalidMessage(t *testing.T) {
 	mockCache.On("SaveGameState", mock.Anything, mock.Anything).Return(nil).Maybe()
 	mockRepo.On("UpdateGameSession", mock.Anything, mock.Anything).Return(nil).Maybe()
 
-	manager := New(game, testPack, mockHub, mockLogger, mockRepo, mockCache)
+	manager := New(game, testPack, mockHub, mockLogger, mockRepo, mockCache, clock.Real())
 	manager.Start()
 
 	userID := uuid.New()
@@ -293,3 +331,1779 @@ func TestManager_HandleClientMessage_InvalidMessage(t *testing.T) {
 	mockLogger.AssertExpectations(t)
 }
 
+
+func createTestPackWithQuestion() (*pack.Pack, *pack.Theme, *pack.Question) {
+	question := &pack.Question{
+		ID:     "q1",
+		Price:  100,
+		Text:   "Question text",
+		Answer: "Secret answer",
+	}
+	theme := &pack.Theme{
+		ID:        "t1",
+		Name:      "Theme",
+		Questions: []*pack.Question{question},
+	}
+	testPack := createTestPack()
+	testPack.Rounds = []*pack.Round{{ID: "r1", RoundNumber: 1, Name: "Round 1", Themes: []*pack.Theme{theme}}}
+	return testPack, theme, question
+}
+
+func encodeMessage(msg interface{}) []byte {
+	data, _ := msg.(*wsMessage.ServerMessage).ToJSON()
+	return data
+}
+
+func renderStateFor(t *testing.T, render func(userID uuid.UUID) *wsMessage.ServerMessage, userID uuid.UUID) *domainGame.State {
+	msg := render(userID)
+	if !assert.NotNil(t, msg) {
+		return nil
+	}
+	data := encodeMessage(msg)
+
+	var decoded struct {
+		Payload domainGame.State `json:"payload"`
+	}
+	assert.NoError(t, json.Unmarshal(data, &decoded))
+	return &decoded.Payload
+}
+
+func recordBroadcasts(mockHub *MockHub, gameID uuid.UUID) map[string][]json.RawMessage {
+	messages := make(map[string][]json.RawMessage)
+	mockHub.On("Broadcast", gameID, mock.Anything).Run(func(args mock.Arguments) {
+		var msg struct {
+			Type    string          `json:"type"`
+			Payload json.RawMessage `json:"payload"`
+		}
+		if err := json.Unmarshal(encodeMessage(args.Get(1)), &msg); err == nil {
+			messages[msg.Type] = append(messages[msg.Type], msg.Payload)
+		}
+	}).Return().Maybe()
+	return messages
+}
+
+func TestManager_BroadcastState_ProjectsPerAudience(t *testing.T) {
+	game := createTestGame()
+	hostID := uuid.New()
+	game.Players[hostID] = player.New(hostID, "host", "", player.RoleHost)
+	var playerID uuid.UUID
+	for userID, p := range game.Players {
+		if p.Role == player.RolePlayer {
+			playerID = userID
+		}
+	}
+
+	testPack, theme, question := createTestPackWithQuestion()
+
+	var render func(userID uuid.UUID) *wsMessage.ServerMessage
+	mockHub := new(MockHub)
+	mockHub.On("BroadcastPersonalized", game.ID, mock.Anything).Run(func(args mock.Arguments) {
+		render = args.Get(1).(func(userID uuid.UUID) *wsMessage.ServerMessage)
+	}).Return()
+
+	manager := New(game, testPack, mockHub, new(MockEventLogger), newPermissiveRepository(), newPermissiveCache(), clock.Real())
+	game.CurrentRound = 1
+	game.SetCurrentQuestion(question, theme.Name)
+	game.UpdateStatus(domainGame.StatusQuestionShow)
+
+	manager.BroadcastStateUnlocked()
+
+	hostState := renderStateFor(t, render, hostID)
+	assert.Equal(t, "Secret answer", hostState.CurrentQuestion.Answer)
+	assert.Equal(t, "Question text", hostState.CurrentQuestion.Text)
+
+	playerState := renderStateFor(t, render, playerID)
+	assert.Empty(t, playerState.CurrentQuestion.Answer)
+	assert.Equal(t, "Question text", playerState.CurrentQuestion.Text)
+	assert.Empty(t, playerState.Themes[0].Questions[0].Text)
+
+	spectatorState := renderStateFor(t, render, uuid.New())
+	assert.Empty(t, spectatorState.CurrentQuestion.Answer)
+}
+
+func TestManager_PlayerRTTIsVisibleToHostOnly(t *testing.T) {
+	game := createTestGame()
+	hostID := uuid.New()
+	game.Players[hostID] = player.New(hostID, "host", "", player.RoleHost)
+	var playerID uuid.UUID
+	for userID, p := range game.Players {
+		if p.Role == player.RolePlayer {
+			playerID = userID
+		}
+	}
+
+	var render func(userID uuid.UUID) *wsMessage.ServerMessage
+	mockHub := new(MockHub)
+	mockHub.On("BroadcastPersonalized", game.ID, mock.Anything).Run(func(args mock.Arguments) {
+		render = args.Get(1).(func(userID uuid.UUID) *wsMessage.ServerMessage)
+	}).Return()
+
+	manager := New(game, createTestPack(), mockHub, new(MockEventLogger), newPermissiveRepository(), newPermissiveCache(), clock.Real())
+	manager.SetPlayerRTT(playerID, 85*time.Millisecond)
+	manager.SetPlayerRTT(uuid.New(), time.Second)
+	manager.BroadcastStateUnlocked()
+
+	rttOf := func(state *domainGame.State, userID uuid.UUID) int64 {
+		for _, p := range state.Players {
+			if p.UserID == userID {
+				return p.RTTMs
+			}
+		}
+		t.Fatalf("player %s not in state", userID)
+		return 0
+	}
+
+	assert.Equal(t, int64(85), rttOf(renderStateFor(t, render, hostID), playerID))
+	assert.Zero(t, rttOf(renderStateFor(t, render, playerID), playerID))
+	assert.Zero(t, rttOf(renderStateFor(t, render, uuid.New()), playerID))
+}
+
+func TestManager_BroadcastState_HidesQuestionBeforeItIsShown(t *testing.T) {
+	game := createTestGame()
+	testPack, theme, question := createTestPackWithQuestion()
+
+	var render func(userID uuid.UUID) *wsMessage.ServerMessage
+	mockHub := new(MockHub)
+	mockHub.On("BroadcastPersonalized", game.ID, mock.Anything).Run(func(args mock.Arguments) {
+		render = args.Get(1).(func(userID uuid.UUID) *wsMessage.ServerMessage)
+	}).Return()
+
+	manager := New(game, testPack, mockHub, new(MockEventLogger), newPermissiveRepository(), newPermissiveCache(), clock.Real())
+	game.CurrentRound = 1
+	game.SetCurrentQuestion(question, theme.Name)
+	game.UpdateStatus(domainGame.StatusStakeBetting)
+
+	manager.BroadcastStateUnlocked()
+
+	for userID := range game.Players {
+		state := renderStateFor(t, render, userID)
+		assert.Empty(t, state.CurrentQuestion.Text)
+		assert.Empty(t, state.CurrentQuestion.Answer)
+		assert.Equal(t, question.Price, state.CurrentQuestion.Price)
+	}
+}
+
+func TestManager_AdmitUser_Spectator(t *testing.T) {
+	game := createTestGame()
+	game.UpdateStatus(domainGame.StatusQuestionSelect)
+	manager := New(game, createTestPack(), new(MockHub), new(MockEventLogger), newPermissiveRepository(), newPermissiveCache(), clock.Real())
+
+	spectatorID := uuid.New()
+	assert.NoError(t, manager.AdmitUser(spectatorID, "viewer"))
+	assert.True(t, game.IsSpectator(spectatorID))
+	assert.Equal(t, domainGame.AudienceSpectator, manager.audienceFor(spectatorID))
+
+	for userID := range game.Players {
+		assert.NoError(t, manager.AdmitUser(userID, "test-player"))
+		assert.False(t, game.IsSpectator(userID))
+	}
+}
+
+func TestManager_AdmitUser_RejectsWhenGameNotRunning(t *testing.T) {
+	game := createTestGame()
+	manager := New(game, createTestPack(), new(MockHub), new(MockEventLogger), newPermissiveRepository(), newPermissiveCache(), clock.Real())
+
+	assert.ErrorIs(t, manager.AdmitUser(uuid.New(), "viewer"), domainGame.ErrGameNotRunning)
+}
+
+func TestManager_SpectatorIsIgnoredByGameplay(t *testing.T) {
+	game := createTestGame()
+	game.UpdateStatus(domainGame.StatusButtonPress)
+	mockHub := new(MockHub)
+	manager := New(game, createTestPack(), mockHub, new(MockEventLogger), newPermissiveRepository(), newPermissiveCache(), clock.Real())
+
+	spectatorID := uuid.New()
+	mockHub.On("BroadcastToUser", game.ID, spectatorID, mock.Anything).Return()
+	spectator := player.New(spectatorID, "viewer", "", player.RoleSpectator)
+	spectator.Score = -1000
+	assert.NoError(t, game.AddSpectator(spectator))
+
+	manager.handlePlayerAction(&PlayerAction{
+		UserID:  spectatorID,
+		Message: &MockClientMessage{msgType: "PRESS_BUTTON", payload: map[string]interface{}{}},
+	})
+
+	assert.False(t, manager.buttonPress.HasPresses())
+	assert.NotEqual(t, spectatorID, manager.selectActivePlayer())
+	for _, score := range manager.calculateFinalScores() {
+		assert.NotEqual(t, spectatorID, score.UserID)
+	}
+}
+
+func createTestFinalPack() *pack.Pack {
+	themes := make([]*pack.Theme, 0, 3)
+	for _, name := range []string{"History", "Music", "Science"} {
+		themes = append(themes, &pack.Theme{
+			ID:   name,
+			Name: name,
+			Questions: []*pack.Question{{
+				ID:     name + "-q",
+				Price:  0,
+				Text:   name + " question",
+				Answer: name + " answer",
+			}},
+		})
+	}
+	testPack := createTestPack()
+	testPack.Rounds = []*pack.Round{{ID: "final", RoundNumber: 1, Name: "Final", Type: pack.RoundTypeFinal, Themes: themes}}
+	return testPack
+}
+
+func TestManager_FinalRound(t *testing.T) {
+	game := createTestGame()
+	game.Players = make(map[uuid.UUID]*player.Player)
+	hostID, leaderID, trailerID, brokeID := uuid.New(), uuid.New(), uuid.New(), uuid.New()
+	game.Players[hostID] = player.New(hostID, "host", "", player.RoleHost)
+	game.Players[leaderID] = player.New(leaderID, "leader", "", player.RolePlayer)
+	game.Players[trailerID] = player.New(trailerID, "trailer", "", player.RolePlayer)
+	game.Players[brokeID] = player.New(brokeID, "broke", "", player.RolePlayer)
+	game.Players[leaderID].Score = 500
+	game.Players[trailerID].Score = 200
+
+	var render func(userID uuid.UUID) *wsMessage.ServerMessage
+	mockHub := new(MockHub)
+	mockHub.On("BroadcastPersonalized", game.ID, mock.Anything).Run(func(args mock.Arguments) {
+		render = args.Get(1).(func(userID uuid.UUID) *wsMessage.ServerMessage)
+	}).Return()
+	stakeErrors := make(map[uuid.UUID][]string)
+	mockHub.On("BroadcastToUser", game.ID, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		var msg struct {
+			Type    string `json:"type"`
+			Payload struct {
+				Code string `json:"code"`
+			} `json:"payload"`
+		}
+		assert.NoError(t, json.Unmarshal(encodeMessage(args.Get(2)), &msg))
+		if msg.Payload.Code == wsMessage.ErrorCodeInvalidStake {
+			userID := args.Get(1).(uuid.UUID)
+			stakeErrors[userID] = append(stakeErrors[userID], msg.Payload.Code)
+		}
+	}).Return().Maybe()
+	broadcasts := recordBroadcasts(mockHub, game.ID)
+	mockLogger := new(MockEventLogger)
+	mockLogger.On("LogEvent", mock.Anything, mock.Anything).Return(nil)
+	mockRepo := new(MockGameRepository)
+	mockRepo.On("UpdateGameSession", mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockCache := new(MockGameCache)
+	mockCache.On("SaveGameState", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	manager := New(game, createTestFinalPack(), mockHub, mockLogger, mockRepo, mockCache, clock.Real())
+	defer manager.Stop()
+	game.CurrentRound = 1
+	game.UpdateStatus(domainGame.StatusRoundStart)
+
+	act := func(userID uuid.UUID, msgType string, payload map[string]interface{}) {
+		manager.handlePlayerAction(&PlayerAction{UserID: userID, Message: &MockClientMessage{msgType: msgType, payload: payload}})
+	}
+
+	manager.handleTimeout()
+	assert.Equal(t, domainGame.StatusFinalThemeSelect, game.Status)
+	assert.Equal(t, []uuid.UUID{trailerID, leaderID}, game.Final.Participants)
+	assert.Equal(t, trailerID, *game.ActivePlayer)
+
+	act(leaderID, "REMOVE_FINAL_THEME", map[string]interface{}{"theme_id": "History"})
+	assert.Empty(t, game.Final.RemovedThemes)
+
+	act(trailerID, "REMOVE_FINAL_THEME", map[string]interface{}{"theme_id": "History"})
+	act(leaderID, "REMOVE_FINAL_THEME", map[string]interface{}{"theme_id": "Music"})
+	assert.Equal(t, domainGame.StatusFinalStake, game.Status)
+	assert.Equal(t, "Science-q", game.CurrentQuestion.ID)
+
+	act(leaderID, "PLACE_FINAL_STAKE", map[string]interface{}{"amount": float64(10000)})
+	act(leaderID, "PLACE_FINAL_STAKE", map[string]interface{}{"amount": float64(0)})
+	assert.NotContains(t, game.Final.Stakes, leaderID)
+	assert.Len(t, stakeErrors[leaderID], 2)
+
+	act(leaderID, "PLACE_FINAL_STAKE", map[string]interface{}{"amount": float64(500)})
+	act(brokeID, "PLACE_FINAL_STAKE", map[string]interface{}{"amount": float64(100)})
+	assert.Equal(t, 500, game.Final.Stakes[leaderID])
+	assert.NotContains(t, game.Final.Stakes, brokeID)
+
+	playerState := renderStateFor(t, render, trailerID)
+	assert.Empty(t, playerState.Final.Stakes)
+	assert.Equal(t, []uuid.UUID{leaderID}, playerState.Final.StakesPlaced)
+	hostState := renderStateFor(t, render, hostID)
+	assert.Equal(t, 500, hostState.Final.Stakes[leaderID])
+
+	act(trailerID, "PLACE_FINAL_STAKE", map[string]interface{}{"amount": float64(150)})
+	assert.Equal(t, domainGame.StatusFinalAnswering, game.Status)
+
+	act(leaderID, "SUBMIT_FINAL_ANSWER", map[string]interface{}{"answer": "wrong"})
+	act(trailerID, "SUBMIT_FINAL_ANSWER", map[string]interface{}{"answer": "Science answer"})
+	assert.Equal(t, domainGame.StatusFinalJudging, game.Status)
+	assert.Equal(t, trailerID, *game.ActivePlayer)
+
+	playerState = renderStateFor(t, render, leaderID)
+	if assert.Len(t, playerState.Final.Answers, 1) {
+		assert.Equal(t, "Science answer", playerState.Final.Answers[0].Answer)
+		assert.Zero(t, playerState.Final.Answers[0].Stake)
+	}
+
+	act(trailerID, "JUDGE_FINAL_ANSWER", map[string]interface{}{"user_id": trailerID.String(), "correct": true})
+	assert.Equal(t, 200, game.Players[trailerID].Score)
+
+	act(hostID, "JUDGE_FINAL_ANSWER", map[string]interface{}{"user_id": trailerID.String(), "correct": true})
+	assert.Equal(t, 350, game.Players[trailerID].Score)
+	assert.Equal(t, leaderID, *game.ActivePlayer)
+
+	act(hostID, "JUDGE_FINAL_ANSWER", map[string]interface{}{"user_id": leaderID.String(), "correct": false})
+	assert.Equal(t, 0, game.Players[leaderID].Score)
+	assert.Equal(t, domainGame.StatusRoundEnd, game.Status)
+
+	if assert.Len(t, broadcasts["ROUND_COMPLETE"], 1) {
+		var complete wsMessage.RoundCompletePayload
+		assert.NoError(t, json.Unmarshal(broadcasts["ROUND_COMPLETE"][0], &complete))
+		assert.Equal(t, 1, complete.RoundNumber)
+		assert.Nil(t, complete.NextRound)
+		if assert.Len(t, complete.Scores, 3) {
+			assert.Equal(t, trailerID, complete.Scores[0].UserID)
+			assert.Equal(t, 350, complete.Scores[0].Score)
+		}
+	}
+}
+
+func TestManager_FinalRound_SkippedWithoutPositiveScores(t *testing.T) {
+	game := createTestGame()
+	mockHub := new(MockHub)
+	mockHub.On("BroadcastPersonalized", game.ID, mock.Anything).Return()
+	broadcasts := recordBroadcasts(mockHub, game.ID)
+	mockLogger := new(MockEventLogger)
+	mockLogger.On("LogEvent", mock.Anything, mock.Anything).Return(nil)
+	mockRepo := new(MockGameRepository)
+	mockRepo.On("UpdateGameSession", mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockCache := new(MockGameCache)
+	mockCache.On("SaveGameState", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	manager := New(game, createTestFinalPack(), mockHub, mockLogger, mockRepo, mockCache, clock.Real())
+	game.CurrentRound = 1
+	game.UpdateStatus(domainGame.StatusRoundStart)
+
+	manager.handleTimeout()
+
+	assert.Equal(t, domainGame.StatusGameEnd, game.Status)
+	assert.Nil(t, game.Final)
+
+	if assert.Len(t, broadcasts["GAME_COMPLETE"], 1) {
+		var complete wsMessage.GameCompletePayload
+		assert.NoError(t, json.Unmarshal(broadcasts["GAME_COMPLETE"][0], &complete))
+		assert.Len(t, complete.Scores, len(game.FinalScores))
+		assert.Equal(t, game.Winners, complete.Winners)
+	}
+}
+
+func TestManager_ForAllAcceptsAlternativeAnswers(t *testing.T) {
+	game := createTestGame()
+	hostID := uuid.New()
+	game.Players[hostID] = player.New(hostID, "host", "", player.RoleHost)
+	var playerID uuid.UUID
+	for userID, p := range game.Players {
+		if p.Role == player.RolePlayer {
+			playerID = userID
+		}
+	}
+
+	testPack, theme, question := createTestPackWithQuestion()
+	question.Type = pack.TypeForAll
+	question.AltAnswers = []string{"Synonym"}
+
+	var render func(userID uuid.UUID) *wsMessage.ServerMessage
+	mockHub := new(MockHub)
+	mockHub.On("BroadcastPersonalized", game.ID, mock.Anything).Run(func(args mock.Arguments) {
+		render = args.Get(1).(func(userID uuid.UUID) *wsMessage.ServerMessage)
+	}).Return()
+	mockRepo := new(MockGameRepository)
+	mockRepo.On("UpdateGameSession", mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockCache := new(MockGameCache)
+	mockCache.On("SaveGameState", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	manager := New(game, testPack, mockHub, new(MockEventLogger), mockRepo, mockCache, clock.Real())
+	defer manager.timer.Stop()
+	game.CurrentRound = 1
+	game.SetCurrentQuestion(question, theme.Name)
+	manager.forAllCollector.Start(question.Answer, question.Price)
+	game.UpdateStatus(domainGame.StatusForAllAnswering)
+
+	manager.BroadcastStateUnlocked()
+	assert.Equal(t, []string{"Synonym"}, renderStateFor(t, render, hostID).CurrentQuestion.AltAnswers)
+	assert.Empty(t, renderStateFor(t, render, playerID).CurrentQuestion.AltAnswers)
+
+	manager.handlePlayerAction(&PlayerAction{
+		UserID:  playerID,
+		Message: &MockClientMessage{msgType: "SUBMIT_FOR_ALL_ANSWER", payload: map[string]interface{}{"answer": " synonym "}},
+	})
+
+	assert.Equal(t, domainGame.StatusForAllResults, game.Status)
+	assert.Equal(t, question.Price, game.Players[playerID].Score)
+}
+
+func TestManager_SubmitAnswer_ShowsMatchConfidenceToHost(t *testing.T) {
+	game := createTestGame()
+	game.Settings.AnswerMatch = domainGame.AnswerMatchEditDistance
+	hostID := uuid.New()
+	game.Players[hostID] = player.New(hostID, "host", "", player.RoleHost)
+	var playerID uuid.UUID
+	for userID, p := range game.Players {
+		if p.Role == player.RolePlayer {
+			playerID = userID
+		}
+	}
+
+	testPack, theme, question := createTestPackWithQuestion()
+	question.Answer = "Достоевский"
+
+	var render func(userID uuid.UUID) *wsMessage.ServerMessage
+	mockHub := new(MockHub)
+	mockHub.On("BroadcastPersonalized", game.ID, mock.Anything).Run(func(args mock.Arguments) {
+		render = args.Get(1).(func(userID uuid.UUID) *wsMessage.ServerMessage)
+	}).Return()
+
+	mockRepo := new(MockGameRepository)
+	mockRepo.On("UpdateGameSession", mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockCache := new(MockGameCache)
+	mockCache.On("SaveGameState", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	manager := New(game, testPack, mockHub, new(MockEventLogger), mockRepo, mockCache, clock.Real())
+	defer manager.timer.Stop()
+	game.CurrentRound = 1
+	game.SetCurrentQuestion(question, theme.Name)
+	game.SetActivePlayer(playerID)
+	game.UpdateStatus(domainGame.StatusAnswering)
+
+	manager.handleSubmitAnswer(&PlayerAction{
+		UserID:  playerID,
+		Message: &MockClientMessage{msgType: "SUBMIT_ANSWER"},
+	}, &wsMessage.SubmitAnswerPayload{Answer: "Достоевскйи"})
+	manager.BroadcastStateUnlocked()
+
+	assert.Equal(t, question.Price, game.Players[playerID].Score)
+
+	hostState := renderStateFor(t, render, hostID)
+	if assert.NotNil(t, hostState.AnswerMatch) {
+		assert.True(t, hostState.AnswerMatch.Matched)
+		assert.True(t, hostState.AnswerMatch.Borderline)
+		assert.Less(t, hostState.AnswerMatch.Confidence, 1.0)
+	}
+	assert.Nil(t, renderStateFor(t, render, playerID).AnswerMatch)
+}
+
+func TestManager_SecretQuestion_SelectionModeAndPrice(t *testing.T) {
+	game := createTestGame()
+	hostID := uuid.New()
+	game.Players[hostID] = player.New(hostID, "host", "", player.RoleHost)
+	var chooserID uuid.UUID
+	for userID, p := range game.Players {
+		if p.Role == player.RolePlayer {
+			chooserID = userID
+		}
+	}
+	receiverID := uuid.New()
+	game.Players[receiverID] = player.New(receiverID, "receiver", "", player.RolePlayer)
+
+	testPack, theme, question := createTestPackWithQuestion()
+	question.Type = pack.TypeSecret
+	question.SecretParams = &pack.SecretParams{
+		SelectionMode: pack.SecretSelectionExceptCurrent,
+		Theme:         "Cats",
+		MinPrice:      100,
+		MaxPrice:      300,
+		PriceStep:     100,
+	}
+
+	var transferred []byte
+	mockHub := new(MockHub)
+	mockHub.On("BroadcastPersonalized", game.ID, mock.Anything).Return()
+	mockHub.On("BroadcastToUser", game.ID, mock.Anything, mock.Anything).Return().Maybe()
+	mockHub.On("Broadcast", game.ID, mock.Anything).Run(func(args mock.Arguments) {
+		transferred = encodeMessage(args.Get(1))
+	}).Return()
+	mockRepo := new(MockGameRepository)
+	mockRepo.On("UpdateGameSession", mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockCache := new(MockGameCache)
+	mockCache.On("SaveGameState", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	manager := New(game, testPack, mockHub, new(MockEventLogger), mockRepo, mockCache, clock.Real())
+	defer manager.timer.Stop()
+	game.CurrentRound = 1
+	game.SetActivePlayer(chooserID)
+	game.UpdateStatus(domainGame.StatusQuestionSelect)
+
+	manager.selectQuestion(theme, question)
+	assert.Equal(t, domainGame.StatusSecretTransfer, game.Status)
+	assert.Equal(t, "Cats", manager.secretInfo.Theme)
+	assert.Equal(t, []uuid.UUID{receiverID}, manager.secretInfo.Candidates)
+
+	act := func(userID uuid.UUID, msgType string, payload map[string]interface{}) {
+		manager.handlePlayerAction(&PlayerAction{UserID: userID, Message: &MockClientMessage{msgType: msgType, payload: payload}})
+	}
+
+	act(chooserID, "TRANSFER_SECRET", map[string]interface{}{"target_user_id": chooserID.String()})
+	assert.Equal(t, domainGame.StatusSecretTransfer, game.Status)
+
+	act(chooserID, "TRANSFER_SECRET", map[string]interface{}{"target_user_id": receiverID.String()})
+	assert.Equal(t, domainGame.StatusSecretPriceSelect, game.Status)
+	assert.Equal(t, receiverID, *game.ActivePlayer)
+
+	var msg struct {
+		Type    string `json:"type"`
+		Payload struct {
+			ToUserID uuid.UUID `json:"to_user_id"`
+			Theme    string    `json:"theme"`
+			Prices   []int     `json:"prices"`
+		} `json:"payload"`
+	}
+	assert.NoError(t, json.Unmarshal(transferred, &msg))
+	assert.Equal(t, "SECRET_TRANSFERRED", msg.Type)
+	assert.Equal(t, receiverID, msg.Payload.ToUserID)
+	assert.Equal(t, "Cats", msg.Payload.Theme)
+	assert.Equal(t, []int{100, 200, 300}, msg.Payload.Prices)
+
+	act(receiverID, "SELECT_SECRET_PRICE", map[string]interface{}{"price": float64(250)})
+	assert.Equal(t, domainGame.StatusSecretPriceSelect, game.Status)
+
+	act(receiverID, "SELECT_SECRET_PRICE", map[string]interface{}{"price": float64(300)})
+	assert.Equal(t, domainGame.StatusQuestionShow, game.Status)
+	assert.Equal(t, 300, game.CurrentQuestion.Price)
+}
+
+func TestManager_StakeQuestion_Auction(t *testing.T) {
+	game := createTestGame()
+	var chooserID uuid.UUID
+	for userID, p := range game.Players {
+		chooserID = userID
+		p.Score = 300
+	}
+	aliceID := uuid.New()
+	game.Players[aliceID] = player.New(aliceID, "alice", "", player.RolePlayer)
+	game.Players[aliceID].Score = 500
+	bobID := uuid.New()
+	game.Players[bobID] = player.New(bobID, "bob", "", player.RolePlayer)
+	game.Players[bobID].Score = 800
+
+	testPack, theme, question := createTestPackWithQuestion()
+	question.Type = pack.TypeStake
+	question.StakeParams = &pack.StakeParams{MaxStake: 500}
+
+	var placed [][]byte
+	mockHub := new(MockHub)
+	mockHub.On("BroadcastPersonalized", game.ID, mock.Anything).Return()
+	mockHub.On("BroadcastToUser", game.ID, mock.Anything, mock.Anything).Return().Maybe()
+	mockHub.On("Broadcast", game.ID, mock.Anything).Run(func(args mock.Arguments) {
+		placed = append(placed, encodeMessage(args.Get(1)))
+	}).Return()
+	mockRepo := new(MockGameRepository)
+	mockRepo.On("UpdateGameSession", mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockCache := new(MockGameCache)
+	mockCache.On("SaveGameState", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	manager := New(game, testPack, mockHub, new(MockEventLogger), mockRepo, mockCache, clock.Real())
+	defer manager.timer.Stop()
+	game.CurrentRound = 1
+	game.SetActivePlayer(chooserID)
+	game.UpdateStatus(domainGame.StatusQuestionSelect)
+
+	manager.selectQuestion(theme, question)
+	assert.Equal(t, domainGame.StatusStakeBetting, game.Status)
+	assert.Equal(t, chooserID, *game.ActivePlayer)
+	assert.Equal(t, question.Price, manager.stakeInfo.MinBet)
+
+	act := func(userID uuid.UUID, msgType string, payload map[string]interface{}) {
+		manager.handlePlayerAction(&PlayerAction{UserID: userID, Message: &MockClientMessage{msgType: msgType, payload: payload}})
+	}
+
+	act(chooserID, "PASS_STAKE", nil)
+	assert.Equal(t, aliceID, *game.ActivePlayer)
+
+	act(bobID, "PLACE_STAKE", map[string]interface{}{"amount": float64(400)})
+	assert.Equal(t, aliceID, *game.ActivePlayer)
+	assert.Nil(t, manager.stakeInfo.Leader)
+
+	act(aliceID, "PLACE_STAKE", map[string]interface{}{"amount": float64(200)})
+	assert.Equal(t, bobID, *game.ActivePlayer)
+
+	act(bobID, "PLACE_STAKE", map[string]interface{}{"amount": float64(100)})
+	assert.Equal(t, bobID, *game.ActivePlayer)
+
+	act(bobID, "PLACE_STAKE", map[string]interface{}{"all_in": true})
+	assert.Equal(t, aliceID, *game.ActivePlayer)
+	assert.True(t, manager.stakeInfo.IsAllIn)
+	assert.Equal(t, 500, manager.stakeInfo.CurrentBet)
+
+	act(aliceID, "PLACE_STAKE", map[string]interface{}{"all_in": true})
+	assert.Equal(t, []uuid.UUID{aliceID}, manager.stakeInfo.AllInTies)
+
+	assert.Equal(t, domainGame.StatusQuestionShow, game.Status)
+	assert.Equal(t, bobID, *game.ActivePlayer)
+	assert.Equal(t, 500, game.CurrentQuestion.Price)
+
+	assert.Len(t, placed, 5)
+	var msg struct {
+		Type    string `json:"type"`
+		Payload struct {
+			UserID uuid.UUID  `json:"user_id"`
+			Amount int        `json:"amount"`
+			AllIn  bool       `json:"all_in"`
+			Passed bool       `json:"passed"`
+			Leader *uuid.UUID `json:"leader"`
+		} `json:"payload"`
+	}
+	assert.NoError(t, json.Unmarshal(placed[0], &msg))
+	assert.Equal(t, "STAKE_PLACED", msg.Type)
+	assert.Equal(t, chooserID, msg.Payload.UserID)
+	assert.True(t, msg.Payload.Passed)
+
+	assert.NoError(t, json.Unmarshal(placed[3], &msg))
+	assert.Equal(t, aliceID, msg.Payload.UserID)
+	assert.True(t, msg.Payload.AllIn)
+	assert.Equal(t, bobID, *msg.Payload.Leader)
+
+	assert.NoError(t, json.Unmarshal(placed[4], &msg))
+	assert.Equal(t, "QUESTION_REVEAL", msg.Type)
+}
+
+func TestManager_PauseResume(t *testing.T) {
+	game := createTestGame()
+	hostID := uuid.New()
+	game.Players[hostID] = player.New(hostID, "host", "", player.RoleHost)
+	var playerID uuid.UUID
+	for userID, p := range game.Players {
+		if p.Role == player.RolePlayer {
+			playerID = userID
+		}
+	}
+
+	testPack, _, _ := createTestPackWithQuestion()
+
+	mockHub := new(MockHub)
+	mockHub.On("BroadcastPersonalized", game.ID, mock.Anything).Return()
+	mockHub.On("BroadcastToUser", game.ID, mock.Anything, mock.Anything).Return().Maybe()
+	mockEventLogger := new(MockEventLogger)
+	mockEventLogger.On("LogEvent", mock.Anything, mock.Anything).Return(nil)
+	mockRepo := new(MockGameRepository)
+	mockRepo.On("UpdateGameSession", mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockCache := new(MockGameCache)
+	mockCache.On("SaveGameState", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	manager := New(game, testPack, mockHub, mockEventLogger, mockRepo, mockCache, clock.Real())
+	defer manager.timer.Stop()
+	game.CurrentRound = 1
+	game.SetActivePlayer(hostID)
+	game.UpdateStatus(domainGame.StatusQuestionSelect)
+	manager.timer.Start(20 * time.Second)
+
+	act := func(userID uuid.UUID, msgType string, payload map[string]interface{}) {
+		manager.handlePlayerAction(&PlayerAction{UserID: userID, Message: &MockClientMessage{msgType: msgType, payload: payload}})
+	}
+
+	act(playerID, "PAUSE_GAME", nil)
+	assert.False(t, game.Paused)
+
+	act(hostID, "PAUSE_GAME", nil)
+	assert.True(t, game.Paused)
+	assert.True(t, manager.timer.IsPaused())
+	assert.True(t, manager.buildGameState().Paused)
+	assert.Equal(t, 19, manager.timer.Remaining())
+
+	act(hostID, "SELECT_QUESTION", map[string]interface{}{"theme_id": "t1", "question_id": "q1"})
+	assert.Equal(t, domainGame.StatusQuestionSelect, game.Status)
+
+	fired := false
+	manager.schedule(tagRoundEnd, 10*time.Millisecond, func() { fired = true })
+
+	select {
+	case <-manager.scheduler.C:
+		t.Fatal("scheduled event fired while the game was paused")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	act(hostID, "RESUME_GAME", nil)
+	assert.False(t, game.Paused)
+	assert.False(t, manager.timer.IsPaused())
+	assert.True(t, manager.timer.IsActive())
+
+	select {
+	case ev := <-manager.scheduler.C:
+		manager.handleScheduledEvent(ev)
+	case <-time.After(time.Second):
+		t.Fatal("scheduled event did not fire after resume")
+	}
+	assert.True(t, fired)
+}
+
+func TestManager_HostModeration(t *testing.T) {
+	game := createTestGame()
+	hostID := uuid.New()
+	game.Players[hostID] = player.New(hostID, "host", "", player.RoleHost)
+	var playerID uuid.UUID
+	for userID, p := range game.Players {
+		if p.Role == player.RolePlayer {
+			playerID = userID
+			p.Score = 200
+		}
+	}
+	otherID := uuid.New()
+	game.Players[otherID] = player.New(otherID, "other", "", player.RolePlayer)
+
+	testPack, theme, question := createTestPackWithQuestion()
+	second := &pack.Question{ID: "q2", Price: 200, Text: "Second", Answer: "Answer"}
+	theme.Questions = append(theme.Questions, second, &pack.Question{ID: "q3", Price: 300, Text: "Third", Answer: "Answer"})
+
+	var events []*event.Event
+	mockHub := new(MockHub)
+	mockHub.On("BroadcastPersonalized", game.ID, mock.Anything).Return()
+	mockHub.On("BroadcastToUser", game.ID, mock.Anything, mock.Anything).Return().Maybe()
+	mockHub.On("Broadcast", game.ID, mock.Anything).Return().Maybe()
+	mockEventLogger := new(MockEventLogger)
+	mockEventLogger.On("LogEvent", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		events = append(events, args.Get(1).(*event.Event))
+	}).Return(nil)
+	mockRepo := new(MockGameRepository)
+	mockRepo.On("UpdateGameSession", mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockCache := new(MockGameCache)
+	mockCache.On("SaveGameState", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	manager := New(game, testPack, mockHub, mockEventLogger, mockRepo, mockCache, clock.Real())
+	defer manager.timer.Stop()
+	game.CurrentRound = 1
+	game.SetActivePlayer(hostID)
+	game.UpdateStatus(domainGame.StatusQuestionSelect)
+
+	act := func(userID uuid.UUID, msgType string, payload map[string]interface{}) {
+		manager.handlePlayerAction(&PlayerAction{UserID: userID, Message: &MockClientMessage{msgType: msgType, payload: payload}})
+	}
+
+	act(otherID, "ADJUST_SCORE", map[string]interface{}{"user_id": playerID.String(), "delta": float64(500)})
+	assert.Equal(t, 200, game.Players[playerID].Score)
+	assert.Empty(t, events)
+
+	act(hostID, "ADJUST_SCORE", map[string]interface{}{"user_id": playerID.String(), "delta": float64(-300)})
+	assert.Equal(t, 0, game.Players[playerID].Score)
+	assert.Len(t, events, 1)
+	assert.Equal(t, event.TypeScoreAdjusted, events[0].EventType)
+	assert.Equal(t, hostID, *events[0].UserID)
+	assert.Equal(t, -300, events[0].Data["delta"])
+	assert.Equal(t, -200, events[0].Data["applied_delta"])
+
+	act(hostID, "ADJUST_SCORE", map[string]interface{}{"user_id": playerID.String(), "delta": float64(150)})
+	assert.Equal(t, 150, game.Players[playerID].Score)
+	assert.Len(t, events, 2)
+
+	act(hostID, "SKIP_QUESTION", nil)
+	assert.Len(t, events, 2)
+
+	manager.selectQuestion(theme, question)
+	manager.game.SetActivePlayer(otherID)
+	game.UpdateStatus(domainGame.StatusAnswering)
+
+	act(hostID, "KICK_PLAYER", map[string]interface{}{"user_id": otherID.String()})
+	assert.False(t, game.Players[otherID].IsActive)
+	assert.Equal(t, domainGame.StatusAnswerReveal, game.Status)
+	assert.Equal(t, event.TypePlayerKicked, events[len(events)-1].EventType)
+
+	act(otherID, "SKIP_QUESTION", nil)
+	assert.Equal(t, domainGame.StatusAnswerReveal, game.Status)
+	act(hostID, "SKIP_QUESTION", nil)
+	assert.Equal(t, domainGame.StatusQuestionSelect, game.Status)
+	assert.Nil(t, game.CurrentQuestion)
+
+	act(otherID, "PAUSE_GAME", nil)
+	assert.False(t, game.Paused)
+
+	manager.selectQuestion(theme, second)
+	assert.NotNil(t, game.CurrentQuestion)
+
+	act(hostID, "SKIP_QUESTION", nil)
+	assert.Equal(t, domainGame.StatusAnswerReveal, game.Status)
+	assert.Equal(t, "q2", manager.buildGameState().AnswerReveal.QuestionID)
+	assert.Equal(t, event.TypeQuestionSkipped, events[len(events)-1].EventType)
+	assert.Equal(t, "q2", *events[len(events)-1].QuestionID)
+
+	act(hostID, "END_ROUND", nil)
+	assert.Equal(t, domainGame.StatusRoundEnd, game.Status)
+	assert.Equal(t, event.TypeRoundEndedByHost, events[len(events)-2].EventType)
+	assert.Equal(t, hostID, *events[len(events)-2].UserID)
+}
+
+func TestManager_RejectedActionRepliesWithError(t *testing.T) {
+	game := createTestGame()
+	hostID := uuid.New()
+	game.Players[hostID] = player.New(hostID, "host", "", player.RoleHost)
+	var playerID uuid.UUID
+	for userID, p := range game.Players {
+		if p.Role == player.RolePlayer {
+			playerID = userID
+		}
+	}
+
+	type reply struct {
+		userID uuid.UUID
+		data   []byte
+	}
+	var replies []reply
+	mockHub := new(MockHub)
+	mockHub.On("BroadcastToUser", game.ID, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		replies = append(replies, reply{userID: args.Get(1).(uuid.UUID), data: encodeMessage(args.Get(2))})
+	}).Return()
+
+	testPack, _, _ := createTestPackWithQuestion()
+	manager := New(game, testPack, mockHub, new(MockEventLogger), newPermissiveRepository(), newPermissiveCache(), clock.Real())
+	game.CurrentRound = 1
+	game.UpdateStatus(domainGame.StatusQuestionSelect)
+
+	act := func(userID uuid.UUID, id, msgType string, payload map[string]interface{}) {
+		manager.handlePlayerAction(&PlayerAction{UserID: userID, Message: &MockClientMessage{id: id, msgType: msgType, payload: payload}})
+	}
+
+	act(playerID, "msg-1", "SELECT_QUESTION", map[string]interface{}{"theme_id": "t1", "question_id": "q1"})
+	act(hostID, "msg-2", "SELECT_QUESTION", map[string]interface{}{"theme_id": "t1", "question_id": "missing"})
+	act(playerID, "msg-3", "PRESS_BUTTON", nil)
+	act(playerID, "msg-4", "DANCE", nil)
+	act(hostID, "msg-5", "SELECT_QUESTION", map[string]interface{}{"theme_id": "t1"})
+	act(hostID, "msg-6", "ADJUST_SCORE", map[string]interface{}{"user_id": playerID.String(), "delta": 1.5})
+	act(hostID, "msg-7", "JUDGE_ANSWER", map[string]interface{}{"user_id": playerID.String(), "correct": true, "score": 100})
+
+	expected := []struct {
+		userID uuid.UUID
+		code   string
+	}{
+		{playerID, "NOT_HOST"},
+		{hostID, "QUESTION_UNAVAILABLE"},
+		{playerID, "INVALID_PHASE"},
+		{playerID, "UNKNOWN_MESSAGE"},
+		{hostID, "INVALID_PAYLOAD"},
+		{hostID, "INVALID_PAYLOAD"},
+		{hostID, "INVALID_PAYLOAD"},
+	}
+	assert.Len(t, replies, len(expected))
+	for i, want := range expected {
+		var msg struct {
+			Type    string `json:"type"`
+			Payload struct {
+				Message string `json:"message"`
+				Code    string `json:"code"`
+				ReplyTo string `json:"reply_to"`
+			} `json:"payload"`
+		}
+		assert.NoError(t, json.Unmarshal(replies[i].data, &msg))
+		assert.Equal(t, want.userID, replies[i].userID)
+		assert.Equal(t, "ERROR", msg.Type)
+		assert.Equal(t, want.code, msg.Payload.Code)
+		assert.Equal(t, fmt.Sprintf("msg-%d", i+1), msg.Payload.ReplyTo)
+		assert.NotEmpty(t, msg.Payload.Message)
+	}
+	assert.Equal(t, domainGame.StatusQuestionSelect, game.Status)
+}
+
+func TestManager_JudgeAnswerRejectsPlayerWhoIsNotAnswering(t *testing.T) {
+	game := createTestGame()
+	hostID := uuid.New()
+	game.Players[hostID] = player.New(hostID, "host", "", player.RoleHost)
+	var answeringID uuid.UUID
+	for userID, p := range game.Players {
+		if p.Role == player.RolePlayer {
+			answeringID = userID
+		}
+	}
+	otherID := uuid.New()
+	game.Players[otherID] = player.New(otherID, "other", "", player.RolePlayer)
+
+	var codes []string
+	mockHub := new(MockHub)
+	mockHub.On("BroadcastToUser", game.ID, hostID, mock.Anything).Run(func(args mock.Arguments) {
+		var msg struct {
+			Payload struct {
+				Code string `json:"code"`
+			} `json:"payload"`
+		}
+		assert.NoError(t, json.Unmarshal(encodeMessage(args.Get(2)), &msg))
+		codes = append(codes, msg.Payload.Code)
+	}).Return()
+
+	testPack, theme, question := createTestPackWithQuestion()
+	manager := New(game, testPack, mockHub, new(MockEventLogger), newPermissiveRepository(), newPermissiveCache(), clock.Real())
+	game.CurrentRound = 1
+	game.SetCurrentQuestion(question, theme.Name)
+	game.SetActivePlayer(answeringID)
+	game.UpdateStatus(domainGame.StatusAnswerJudging)
+
+	manager.handlePlayerAction(&PlayerAction{
+		UserID:  hostID,
+		Message: &MockClientMessage{msgType: "JUDGE_ANSWER", payload: map[string]interface{}{"correct": true, "user_id": otherID.String()}},
+	})
+
+	assert.Equal(t, []string{wsMessage.ErrorCodeNotYourTurn}, codes)
+	assert.Equal(t, domainGame.StatusAnswerJudging, game.Status)
+	assert.Zero(t, game.Players[otherID].Score)
+	assert.Zero(t, game.Players[answeringID].Score)
+}
+
+func TestManager_ReadyIsAcceptedWithoutReply(t *testing.T) {
+	game := createTestGame()
+	var playerID uuid.UUID
+	for userID := range game.Players {
+		playerID = userID
+	}
+
+	manager := New(game, createTestPack(), new(MockHub), new(MockEventLogger), newPermissiveRepository(), newPermissiveCache(), clock.Real())
+	game.UpdateStatus(domainGame.StatusQuestionSelect)
+
+	manager.handlePlayerAction(&PlayerAction{UserID: playerID, Message: &MockClientMessage{id: "msg-1", msgType: "READY"}})
+	assert.NoError(t, game.Pause())
+	manager.handlePlayerAction(&PlayerAction{UserID: playerID, Message: &MockClientMessage{id: "msg-2", msgType: "READY"}})
+
+	assert.Equal(t, domainGame.StatusQuestionSelect, game.Status)
+}
+
+func TestManager_ScheduledEventsAreTaggedAndCancelled(t *testing.T) {
+	game := createTestGame()
+	manager := New(game, createTestPack(), new(MockHub), new(MockEventLogger), newPermissiveRepository(), newPermissiveCache(), clock.Real())
+	defer manager.scheduler.CancelAll()
+	game.UpdateStatus(domainGame.StatusButtonPress)
+
+	runs := make(map[string]int)
+	manager.schedule(tagButtonPressCollection, time.Millisecond, func() { runs["first"]++ })
+	manager.schedule(tagButtonPressCollection, time.Millisecond, func() { runs["second"]++ })
+	manager.schedule(tagForAllRead, time.Millisecond, func() { runs["for_all"]++ })
+	manager.scheduler.Cancel(tagForAllRead)
+	manager.schedule(tagRoundEnd, 20*time.Millisecond, func() { runs["round_end"]++ })
+
+	drain := func() {
+		for {
+			select {
+			case ev := <-manager.scheduler.C:
+				manager.handleScheduledEvent(ev)
+			case <-time.After(50 * time.Millisecond):
+				return
+			}
+		}
+	}
+
+	drain()
+	assert.Equal(t, map[string]int{"second": 1, "round_end": 1}, runs)
+
+	manager.schedule(tagButtonPressCollection, time.Millisecond, func() { runs["stale"]++ })
+	game.UpdateStatus(domainGame.StatusAnswerJudging)
+	drain()
+	assert.Zero(t, runs["stale"])
+	assert.NotContains(t, manager.scheduler.events, tagButtonPressCollection)
+}
+
+func TestManager_FakeClockDrivesQuestionFlow(t *testing.T) {
+	game := createTestGame()
+	hostID := uuid.New()
+	game.Players[hostID] = player.New(hostID, "host", "", player.RoleHost)
+	var playerID uuid.UUID
+	for userID, p := range game.Players {
+		if p.Role == player.RolePlayer {
+			playerID = userID
+		}
+	}
+
+	mockHub := new(MockHub)
+	mockHub.On("BroadcastPersonalized", game.ID, mock.Anything).Return().Maybe()
+	var reveals []wsMessage.QuestionRevealPayload
+	var transient []bool
+	mockHub.On("Broadcast", game.ID, mock.Anything).Run(func(args mock.Arguments) {
+		var msg struct {
+			Type    string                          `json:"type"`
+			Payload wsMessage.QuestionRevealPayload `json:"payload"`
+		}
+		assert.NoError(t, json.Unmarshal(encodeMessage(args.Get(1)), &msg))
+		if msg.Type == "QUESTION_REVEAL" {
+			reveals = append(reveals, msg.Payload)
+			transient = append(transient, args.Get(1).(*wsMessage.ServerMessage).Transient)
+		}
+	}).Return().Maybe()
+	mockHub.On("BroadcastToUser", game.ID, mock.Anything, mock.Anything).Return().Maybe()
+	mockHub.On("GetClientRTT", game.ID, playerID).Return(time.Duration(0))
+	mockLogger := new(MockEventLogger)
+	mockLogger.On("LogEvent", mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockRepo := new(MockGameRepository)
+	mockRepo.On("UpdateGameSession", mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockCache := new(MockGameCache)
+	mockCache.On("SaveGameState", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	fake := clock.NewFake(time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC))
+	testPack, _, question := createTestPackWithQuestion()
+	manager := New(game, testPack, mockHub, mockLogger, mockRepo, mockCache, fake)
+	defer manager.timer.Stop()
+	defer manager.scheduler.CancelAll()
+	game.CurrentRound = 1
+	game.Players[playerID].Score = 500
+	game.UpdateStatus(domainGame.StatusQuestionSelect)
+
+	fireTimer := func(d time.Duration) {
+		fake.Advance(d)
+		select {
+		case <-manager.timer.C:
+			manager.handleTimeout()
+		case <-time.After(time.Second):
+			t.Fatalf("timer did not fire after advancing %v in status %s", d, game.Status)
+		}
+	}
+	pumpScheduled := func() {
+		for {
+			select {
+			case ev := <-manager.scheduler.C:
+				manager.handleScheduledEvent(ev)
+			case <-time.After(20 * time.Millisecond):
+				return
+			}
+		}
+	}
+
+	manager.handlePlayerAction(&PlayerAction{
+		UserID:  hostID,
+		Message: &MockClientMessage{msgType: "SELECT_QUESTION", payload: map[string]interface{}{"theme_id": "t1", "question_id": "q1"}},
+	})
+	assert.Equal(t, domainGame.StatusQuestionShow, game.Status)
+
+	fake.Advance(MinQuestionReadDuration / 2)
+	pumpScheduled()
+	assert.Equal(t, domainGame.StatusQuestionShow, game.Status)
+	if assert.NotEmpty(t, reveals) {
+		last := reveals[len(reveals)-1]
+		assert.Greater(t, last.RevealedChars, 0)
+		assert.Less(t, last.RevealedChars, last.TotalChars)
+		assert.True(t, transient[len(transient)-1])
+	}
+
+	fireTimer(MinQuestionReadDuration / 2)
+	pumpScheduled()
+	assert.Equal(t, domainGame.StatusButtonPress, game.Status)
+	assert.Equal(t, len(question.Text), reveals[len(reveals)-1].RevealedChars)
+	assert.False(t, transient[len(transient)-1])
+
+	manager.handlePlayerAction(&PlayerAction{
+		UserID:  playerID,
+		Message: &MockClientMessage{msgType: "PRESS_BUTTON"},
+	})
+	fake.Advance(game.Settings.GetButtonWindow())
+	pumpScheduled()
+	assert.Equal(t, domainGame.StatusAnswerJudging, game.Status)
+	assert.Equal(t, playerID, *game.ActivePlayer)
+
+	fireTimer(time.Duration(game.Settings.TimeForAnswer) * time.Second)
+	assert.Equal(t, 500-question.Price, game.Players[playerID].Score)
+	assert.Equal(t, domainGame.StatusAnswerReveal, game.Status)
+	assert.Equal(t, []domainGame.ScoreChange{{UserID: playerID, Username: "test-player", Delta: -question.Price, Score: 500 - question.Price}}, manager.buildGameState().AnswerReveal.ScoreChanges)
+
+	fireTimer(game.Settings.GetAnswerRevealDuration())
+	assert.Contains(t, manager.scheduler.events, tagRoundEnd)
+}
+
+func TestManager_TransitionRejectsIllegalJump(t *testing.T) {
+	game := createTestGame()
+	hostID := uuid.New()
+	game.Players[hostID] = player.New(hostID, "host", "", player.RoleHost)
+	manager := New(game, createTestPack(), new(MockHub), new(MockEventLogger), newPermissiveRepository(), newPermissiveCache(), clock.Real())
+	game.CurrentRound = 1
+	game.UpdateStatus(domainGame.StatusRoundEnd)
+
+	assert.False(t, manager.transition(domainGame.StatusAnswering))
+	assert.Equal(t, domainGame.StatusRoundEnd, game.Status)
+
+	err := game.TransitionTo(domainGame.StatusAnswering)
+	assert.ErrorIs(t, err, domainGame.ErrIllegalTransition)
+
+	game.UpdateStatus(domainGame.StatusQuestionSelect)
+	err = game.TransitionTo(domainGame.StatusQuestionShow)
+	assert.ErrorIs(t, err, domainGame.ErrTransitionGuard)
+	assert.Equal(t, domainGame.StatusQuestionSelect, game.Status)
+
+	game.SetCurrentQuestion(&pack.Question{ID: "q1", Price: 100}, "Theme")
+	assert.True(t, manager.transition(domainGame.StatusRoundEnd))
+	assert.Nil(t, game.CurrentQuestion)
+
+	for _, transition := range domainGame.Machine.Transitions() {
+		assert.Contains(t, domainGame.Machine.Mermaid(), fmt.Sprintf("%s --> %s", transition.From, transition.To))
+		assert.Contains(t, domainGame.Machine.Graphviz(), fmt.Sprintf("%q -> %q", transition.From, transition.To))
+	}
+}
+
+func TestManager_WrongAnswerReopensButtons(t *testing.T) {
+	game := createTestGame()
+	game.Settings.ReopenButtons = true
+	hostID := uuid.New()
+	game.Players[hostID] = player.New(hostID, "host", "", player.RoleHost)
+	var firstID uuid.UUID
+	for userID, p := range game.Players {
+		if p.Role == player.RolePlayer {
+			firstID = userID
+		}
+	}
+	secondID := uuid.New()
+	game.Players[secondID] = player.New(secondID, "second", "", player.RolePlayer)
+	game.Players[firstID].Score = 500
+	game.Players[secondID].Score = 500
+
+	mockHub := new(MockHub)
+	mockHub.On("BroadcastPersonalized", game.ID, mock.Anything).Return().Maybe()
+	mockHub.On("BroadcastToUser", game.ID, mock.Anything, mock.Anything).Return().Maybe()
+	mockHub.On("GetClientRTT", game.ID, mock.Anything).Return(time.Duration(0))
+	broadcasts := recordBroadcasts(mockHub, game.ID)
+	mockRepo := new(MockGameRepository)
+	mockRepo.On("UpdateGameSession", mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockCache := new(MockGameCache)
+	mockCache.On("SaveGameState", mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockLogger := new(MockEventLogger)
+	mockLogger.On("LogEvent", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	fake := clock.NewFake(time.Unix(0, 0))
+	testPack, theme, question := createTestPackWithQuestion()
+	theme.Questions = append(theme.Questions, &pack.Question{ID: "q2", Price: 200, Text: "Next", Answer: "Next"})
+	manager := New(game, testPack, mockHub, mockLogger, mockRepo, mockCache, fake)
+	defer manager.timer.Stop()
+	defer manager.scheduler.CancelAll()
+	game.CurrentRound = 1
+	question.MarkAsUsed()
+	game.SetCurrentQuestion(question, theme.Name)
+	game.UpdateStatus(domainGame.StatusQuestionShow)
+	manager.transitionToButtonPress()
+
+	press := func(userID uuid.UUID) {
+		manager.handlePlayerAction(&PlayerAction{UserID: userID, Message: &MockClientMessage{msgType: "PRESS_BUTTON"}})
+	}
+	collect := func() {
+		fake.Advance(game.Settings.GetButtonWindow())
+		select {
+		case ev := <-manager.scheduler.C:
+			manager.handleScheduledEvent(ev)
+		case <-time.After(time.Second):
+			t.Fatal("button press collection did not fire")
+		}
+	}
+	judgeWrong := func(userID uuid.UUID) {
+		manager.handlePlayerAction(&PlayerAction{
+			UserID:  hostID,
+			Message: &MockClientMessage{msgType: "JUDGE_ANSWER", payload: map[string]interface{}{"correct": false, "user_id": userID.String()}},
+		})
+	}
+
+	press(firstID)
+	collect()
+	assert.Equal(t, firstID, *game.ActivePlayer)
+
+	judgeWrong(firstID)
+	assert.Equal(t, domainGame.StatusButtonPress, game.Status)
+	assert.Equal(t, 500-question.Price, game.Players[firstID].Score)
+	assert.Equal(t, []uuid.UUID{firstID}, manager.buildGameState().LockedOut)
+
+	press(firstID)
+	assert.False(t, manager.buttonPress.HasPresses())
+
+	press(secondID)
+	collect()
+	assert.Equal(t, domainGame.StatusAnswerJudging, game.Status)
+	assert.Equal(t, secondID, *game.ActivePlayer)
+
+	judgeWrong(secondID)
+	assert.Equal(t, domainGame.StatusAnswerReveal, game.Status)
+	assert.Len(t, manager.buildGameState().AnswerReveal.ScoreChanges, 2)
+	manager.finishAnswerReveal()
+	assert.Equal(t, domainGame.StatusQuestionSelect, game.Status)
+	assert.Equal(t, 500-question.Price, game.Players[secondID].Score)
+	assert.Empty(t, manager.buildGameState().LockedOut)
+
+	if assert.Len(t, broadcasts["BUTTON_PRESSED"], 2) {
+		var pressed wsMessage.ButtonPressedPayload
+		assert.NoError(t, json.Unmarshal(broadcasts["BUTTON_PRESSED"][1], &pressed))
+		assert.Equal(t, secondID, pressed.WinnerID)
+		assert.Equal(t, "second", pressed.WinnerName)
+		if assert.Len(t, pressed.AllPresses, 1) {
+			assert.Equal(t, secondID, pressed.AllPresses[0].UserID)
+		}
+	}
+	if assert.Len(t, broadcasts["ANSWER_RESULT"], 2) {
+		var result wsMessage.AnswerResultPayload
+		assert.NoError(t, json.Unmarshal(broadcasts["ANSWER_RESULT"][0], &result))
+		assert.Equal(t, firstID, result.UserID)
+		assert.False(t, result.Correct)
+		assert.Equal(t, -question.Price, result.ScoreDelta)
+		assert.Equal(t, 500-question.Price, result.Score)
+	}
+}
+
+func TestManager_AnswerTimeoutReportsResultAndKeepsPressWindow(t *testing.T) {
+	tests := []struct {
+		name       string
+		pressAfter func(s domainGame.Settings) time.Duration
+		wantStatus domainGame.Status
+	}{
+		{name: "window left", pressAfter: func(domainGame.Settings) time.Duration { return 2 * time.Second }, wantStatus: domainGame.StatusButtonPress},
+		{name: "window over", pressAfter: func(s domainGame.Settings) time.Duration { return time.Duration(s.TimeForAnswer) * time.Second }, wantStatus: domainGame.StatusAnswerReveal},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			game := createTestGame()
+			game.Settings.ReopenButtons = true
+			var firstID uuid.UUID
+			for userID := range game.Players {
+				firstID = userID
+			}
+			secondID := uuid.New()
+			game.Players[secondID] = player.New(secondID, "second", "", player.RolePlayer)
+			game.Players[firstID].Score = 500
+
+			mockHub := new(MockHub)
+			mockHub.On("BroadcastPersonalized", game.ID, mock.Anything).Return().Maybe()
+			mockHub.On("GetClientRTT", game.ID, mock.Anything).Return(time.Duration(0))
+			broadcasts := recordBroadcasts(mockHub, game.ID)
+			mockLogger := new(MockEventLogger)
+			mockLogger.On("LogEvent", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+			fake := clock.NewFake(time.Unix(0, 0))
+			testPack, theme, question := createTestPackWithQuestion()
+			manager := New(game, testPack, mockHub, mockLogger, newPermissiveRepository(), newPermissiveCache(), fake)
+			defer manager.timer.Stop()
+			defer manager.scheduler.CancelAll()
+			game.CurrentRound = 1
+			question.MarkAsUsed()
+			game.SetCurrentQuestion(question, theme.Name)
+			game.UpdateStatus(domainGame.StatusQuestionShow)
+			manager.transitionToButtonPress()
+
+			pressAfter := tt.pressAfter(game.Settings)
+			fake.Advance(pressAfter)
+			manager.handlePlayerAction(&PlayerAction{UserID: firstID, Message: &MockClientMessage{msgType: "PRESS_BUTTON"}})
+			fake.Advance(game.Settings.GetButtonWindow())
+			manager.handleScheduledEvent(<-manager.scheduler.C)
+			assert.Equal(t, domainGame.StatusAnswerJudging, game.Status)
+
+			manager.handleAnswerTimeout()
+			assert.Equal(t, tt.wantStatus, game.Status)
+			if tt.wantStatus == domainGame.StatusButtonPress {
+				window := time.Duration(game.Settings.TimeForAnswer)*time.Second - pressAfter - game.Settings.GetButtonWindow()
+				assert.Equal(t, window, manager.timer.RemainingDuration())
+			}
+
+			if assert.Len(t, broadcasts["ANSWER_RESULT"], 1) {
+				var result wsMessage.AnswerResultPayload
+				assert.NoError(t, json.Unmarshal(broadcasts["ANSWER_RESULT"][0], &result))
+				assert.Equal(t, firstID, result.UserID)
+				assert.False(t, result.Correct)
+				assert.Equal(t, -question.Price, result.ScoreDelta)
+			}
+		})
+	}
+}
+
+func TestManager_FalseStartLocksOutEarlyBuzzer(t *testing.T) {
+	game := createTestGame()
+	game.Settings.FalseStartLockoutMs = 1000
+	hostID := uuid.New()
+	game.Players[hostID] = player.New(hostID, "host", "", player.RoleHost)
+	var playerID uuid.UUID
+	for userID, p := range game.Players {
+		if p.Role == player.RolePlayer {
+			playerID = userID
+		}
+	}
+
+	var messages []string
+	mockHub := new(MockHub)
+	broadcasts := recordBroadcasts(mockHub, game.ID)
+	mockHub.On("BroadcastPersonalized", game.ID, mock.Anything).Return().Maybe()
+	mockHub.On("BroadcastToUser", game.ID, playerID, mock.Anything).Run(func(args mock.Arguments) {
+		var msg struct {
+			Type string `json:"type"`
+		}
+		assert.NoError(t, json.Unmarshal(encodeMessage(args.Get(2)), &msg))
+		messages = append(messages, msg.Type)
+	}).Return()
+	mockHub.On("GetClientRTT", game.ID, playerID).Return(time.Duration(0))
+
+	fake := clock.NewFake(time.Unix(0, 0))
+	testPack, _, _ := createTestPackWithQuestion()
+	manager := New(game, testPack, mockHub, new(MockEventLogger), newPermissiveRepository(), newPermissiveCache(), fake)
+	defer manager.timer.Stop()
+	defer manager.scheduler.CancelAll()
+	game.CurrentRound = 1
+	game.UpdateStatus(domainGame.StatusQuestionSelect)
+
+	manager.handlePlayerAction(&PlayerAction{
+		UserID:  hostID,
+		Message: &MockClientMessage{msgType: "SELECT_QUESTION", payload: map[string]interface{}{"theme_id": "t1", "question_id": "q1"}},
+	})
+	assert.Equal(t, domainGame.StatusQuestionShow, game.Status)
+
+	press := func() {
+		manager.handlePlayerAction(&PlayerAction{UserID: playerID, Message: &MockClientMessage{msgType: "PRESS_BUTTON"}})
+	}
+
+	press()
+	press()
+	assert.Equal(t, []string{"FALSE_START"}, messages)
+
+	fake.Advance(MinQuestionReadDuration)
+	<-manager.timer.C
+	manager.handleTimeout()
+	assert.Equal(t, domainGame.StatusButtonPress, game.Status)
+
+	press()
+	assert.Equal(t, []string{"FALSE_START", "ERROR"}, messages)
+	assert.False(t, manager.buttonPress.HasPresses())
+
+	fake.Advance(time.Second)
+	press()
+	assert.True(t, manager.buttonPress.HasPresses())
+
+	fake.Advance(game.Settings.GetButtonWindow())
+	for {
+		ev := <-manager.scheduler.C
+		manager.handleScheduledEvent(ev)
+		if ev.tag == tagButtonPressCollection {
+			break
+		}
+	}
+	if assert.Len(t, broadcasts["BUTTON_PRESSED"], 1) {
+		var payload wsMessage.ButtonPressedPayload
+		assert.NoError(t, json.Unmarshal(broadcasts["BUTTON_PRESSED"][0], &payload))
+		assert.Equal(t, []wsMessage.FalseStartInfo{{UserID: playerID, Username: game.Players[playerID].Username}}, payload.FalseStarts)
+	}
+}
+
+func TestManager_PressButtonJudgedByCorrectedClientTime(t *testing.T) {
+	game := createTestGame()
+	var slowLinkID uuid.UUID
+	for userID := range game.Players {
+		slowLinkID = userID
+	}
+	fastLinkID := uuid.New()
+	game.Players[fastLinkID] = player.New(fastLinkID, "fast-link", "", player.RolePlayer)
+
+	mockHub := new(MockHub)
+	mockHub.On("GetClientRTT", game.ID, slowLinkID).Return(400 * time.Millisecond)
+	mockHub.On("GetClientRTT", game.ID, fastLinkID).Return(20 * time.Millisecond)
+
+	fake := clock.NewFake(time.Unix(1000, 0))
+	manager := New(game, createTestPack(), mockHub, new(MockEventLogger), newPermissiveRepository(), newPermissiveCache(), fake)
+	defer manager.scheduler.CancelAll()
+	game.UpdateStatus(domainGame.StatusButtonPress)
+	manager.buttonPress.Open(0)
+	openedAt := fake.Now()
+
+	manager.SetPlayerClockOffset(slowLinkID, 2*time.Second)
+	manager.SetPlayerClockOffset(fastLinkID, 0)
+	press := func(userID uuid.UUID, clientTime time.Time) {
+		manager.handlePlayerAction(&PlayerAction{
+			UserID:  userID,
+			Message: &MockClientMessage{msgType: "PRESS_BUTTON", payload: map[string]interface{}{"client_time": clientTime.UnixMilli()}},
+		})
+	}
+
+	fake.Advance(200 * time.Millisecond)
+	press(fastLinkID, openedAt.Add(190*time.Millisecond))
+	fake.Advance(100 * time.Millisecond)
+	press(slowLinkID, openedAt.Add(2*time.Second+30*time.Millisecond))
+
+	winner := manager.buttonPress.GetWinner()
+	if assert.NotNil(t, winner) {
+		assert.Equal(t, slowLinkID, winner.UserID)
+		assert.Equal(t, int64(30), manager.buttonPress.GetReactionTime(winner))
+		assert.False(t, winner.Suspicious)
+	}
+
+	presses := manager.buttonPress.GetAllPresses()
+	for i := range presses {
+		if presses[i].UserID == fastLinkID {
+			assert.Equal(t, int64(190), manager.buttonPress.GetReactionTime(&presses[i]))
+		}
+	}
+}
+
+func TestManager_PressButtonForgedTimestampDoesNotWin(t *testing.T) {
+	tests := []struct {
+		name        string
+		offset      time.Duration
+		clientDelay time.Duration
+	}{
+		{name: "forged client_time", offset: 0, clientDelay: 10 * time.Millisecond},
+		{name: "offset skewed by forged server_time", offset: 400 * time.Millisecond, clientDelay: 300 * time.Millisecond},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			game := createTestGame()
+			var cheaterID uuid.UUID
+			for userID := range game.Players {
+				cheaterID = userID
+			}
+			honestID := uuid.New()
+			game.Players[honestID] = player.New(honestID, "honest", "", player.RolePlayer)
+
+			mockHub := new(MockHub)
+			mockHub.On("GetClientRTT", game.ID, mock.Anything).Return(40 * time.Millisecond)
+
+			fake := clock.NewFake(time.Unix(1000, 0))
+			manager := New(game, createTestPack(), mockHub, new(MockEventLogger), newPermissiveRepository(), newPermissiveCache(), fake)
+			defer manager.scheduler.CancelAll()
+			game.UpdateStatus(domainGame.StatusButtonPress)
+			manager.buttonPress.Open(0)
+			openedAt := fake.Now()
+
+			manager.SetPlayerClockOffset(cheaterID, tt.offset)
+			manager.SetPlayerClockOffset(honestID, 0)
+			press := func(userID uuid.UUID, clientTime time.Time) {
+				manager.handlePlayerAction(&PlayerAction{
+					UserID:  userID,
+					Message: &MockClientMessage{msgType: "PRESS_BUTTON", payload: map[string]interface{}{"client_time": clientTime.UnixMilli()}},
+				})
+			}
+
+			fake.Advance(290 * time.Millisecond)
+			press(honestID, openedAt.Add(270*time.Millisecond))
+			fake.Advance(10 * time.Millisecond)
+			press(cheaterID, openedAt.Add(tt.clientDelay))
+
+			winner := manager.buttonPress.GetWinner()
+			if assert.NotNil(t, winner) {
+				assert.Equal(t, honestID, winner.UserID)
+				assert.Equal(t, int64(270), manager.buttonPress.GetReactionTime(winner))
+			}
+
+			presses := manager.buttonPress.GetAllPresses()
+			for i := range presses {
+				if presses[i].UserID == cheaterID {
+					assert.True(t, presses[i].Suspicious)
+					assert.Equal(t, int64(280), manager.buttonPress.GetReactionTime(&presses[i]))
+				}
+			}
+		})
+	}
+}
+
+func TestManager_KickedFirstPresserDoesNotWinButton(t *testing.T) {
+	game := createTestGame()
+	hostID := uuid.New()
+	game.Players[hostID] = player.New(hostID, "host", "", player.RoleHost)
+	var kickedID uuid.UUID
+	for userID, p := range game.Players {
+		if p.Role == player.RolePlayer {
+			kickedID = userID
+			p.Score = 300
+		}
+	}
+	otherID := uuid.New()
+	game.Players[otherID] = player.New(otherID, "other", "", player.RolePlayer)
+
+	mockHub := new(MockHub)
+	mockHub.On("BroadcastPersonalized", game.ID, mock.Anything).Return().Maybe()
+	mockHub.On("Broadcast", game.ID, mock.Anything).Return().Maybe()
+	mockHub.On("GetClientRTT", game.ID, mock.Anything).Return(time.Duration(0))
+	mockLogger := new(MockEventLogger)
+	mockLogger.On("LogEvent", mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockRepo := new(MockGameRepository)
+	mockRepo.On("UpdateGameSession", mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockCache := new(MockGameCache)
+	mockCache.On("SaveGameState", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	fake := clock.NewFake(time.Unix(0, 0))
+	testPack, _, question := createTestPackWithQuestion()
+	manager := New(game, testPack, mockHub, mockLogger, mockRepo, mockCache, fake)
+	defer manager.timer.Stop()
+	defer manager.scheduler.CancelAll()
+	game.CurrentRound = 1
+	game.CurrentQuestion = question
+	game.UpdateStatus(domainGame.StatusButtonPress)
+	manager.buttonPress.Open(0)
+
+	act := func(userID uuid.UUID, msgType string, payload map[string]interface{}) {
+		manager.handlePlayerAction(&PlayerAction{UserID: userID, Message: &MockClientMessage{msgType: msgType, payload: payload}})
+	}
+
+	act(kickedID, "PRESS_BUTTON", nil)
+	fake.Advance(20 * time.Millisecond)
+	act(otherID, "PRESS_BUTTON", nil)
+	act(hostID, "KICK_PLAYER", map[string]interface{}{"user_id": kickedID.String()})
+
+	manager.finishButtonPressCollection()
+	assert.Equal(t, domainGame.StatusAnswerJudging, game.Status)
+	if assert.NotNil(t, game.ActivePlayer) {
+		assert.Equal(t, otherID, *game.ActivePlayer)
+	}
+	assert.Equal(t, 300, game.Players[kickedID].Score)
+}
+
+func TestManager_KickOnlyPresserKeepsButtonOpen(t *testing.T) {
+	game := createTestGame()
+	hostID := uuid.New()
+	game.Players[hostID] = player.New(hostID, "host", "", player.RoleHost)
+	var kickedID uuid.UUID
+	for userID, p := range game.Players {
+		if p.Role == player.RolePlayer {
+			kickedID = userID
+		}
+	}
+	otherID := uuid.New()
+	game.Players[otherID] = player.New(otherID, "other", "", player.RolePlayer)
+
+	mockHub := new(MockHub)
+	mockHub.On("BroadcastPersonalized", game.ID, mock.Anything).Return().Maybe()
+	mockHub.On("GetClientRTT", game.ID, mock.Anything).Return(time.Duration(0))
+	mockLogger := new(MockEventLogger)
+	mockLogger.On("LogEvent", mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockRepo := new(MockGameRepository)
+	mockRepo.On("UpdateGameSession", mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockCache := new(MockGameCache)
+	mockCache.On("SaveGameState", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	testPack, _, question := createTestPackWithQuestion()
+	manager := New(game, testPack, mockHub, mockLogger, mockRepo, mockCache, clock.NewFake(time.Unix(0, 0)))
+	defer manager.timer.Stop()
+	defer manager.scheduler.CancelAll()
+	game.CurrentRound = 1
+	game.CurrentQuestion = question
+	game.UpdateStatus(domainGame.StatusButtonPress)
+	manager.buttonPress.Open(0)
+
+	manager.handlePlayerAction(&PlayerAction{UserID: kickedID, Message: &MockClientMessage{msgType: "PRESS_BUTTON"}})
+	manager.handlePlayerAction(&PlayerAction{UserID: hostID, Message: &MockClientMessage{msgType: "KICK_PLAYER", payload: map[string]interface{}{"user_id": kickedID.String()}}})
+
+	manager.finishButtonPressCollection()
+	assert.Equal(t, domainGame.StatusButtonPress, game.Status)
+	assert.False(t, manager.buttonPress.IsClosed())
+	assert.Nil(t, game.ActivePlayer)
+}
+
+func TestManager_QuestionReadTimeScalesWithText(t *testing.T) {
+	game := createTestGame()
+	manager := New(game, createTestPack(), new(MockHub), new(MockEventLogger), newPermissiveRepository(), newPermissiveCache(), clock.Real())
+
+	assert.Equal(t, MinQuestionReadDuration, manager.questionReadTime(&pack.Question{Text: "Short"}))
+
+	long := &pack.Question{Text: strings.Repeat("й", 200)}
+	assert.Equal(t, 10*time.Second, manager.questionReadTime(long))
+
+	game.Settings.ReadingSpeed = 40
+	assert.Equal(t, 5*time.Second, manager.questionReadTime(long))
+
+	long.MediaDurationMs = 1500
+	assert.Equal(t, 6500*time.Millisecond, manager.questionReadTime(long))
+}
+
+func TestManager_LastCorrectPlayerSelectsNextQuestion(t *testing.T) {
+	game := createTestGame()
+	game.Settings.SelectionPolicy = domainGame.SelectionByLastCorrect
+	hostID := uuid.New()
+	game.Players[hostID] = player.New(hostID, "host", "", player.RoleHost)
+	var firstID uuid.UUID
+	for userID, p := range game.Players {
+		if p.Role == player.RolePlayer {
+			firstID = userID
+		}
+	}
+	secondID := uuid.New()
+	game.Players[secondID] = player.New(secondID, "second", "", player.RolePlayer)
+	game.Players[firstID].Score = 1000
+
+	var codes []string
+	mockHub := new(MockHub)
+	mockHub.On("Broadcast", game.ID, mock.Anything).Return().Maybe()
+	mockHub.On("BroadcastPersonalized", game.ID, mock.Anything).Return().Maybe()
+	mockHub.On("BroadcastToUser", game.ID, mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		var msg struct {
+			Payload struct {
+				Code string `json:"code"`
+			} `json:"payload"`
+		}
+		assert.NoError(t, json.Unmarshal(encodeMessage(args.Get(2)), &msg))
+		codes = append(codes, msg.Payload.Code)
+	}).Return().Maybe()
+	mockHub.On("GetClientRTT", game.ID, mock.Anything).Return(time.Duration(0)).Maybe()
+	mockRepo := new(MockGameRepository)
+	mockRepo.On("UpdateGameSession", mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockCache := new(MockGameCache)
+	mockCache.On("SaveGameState", mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockLogger := new(MockEventLogger)
+	mockLogger.On("LogEvent", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	testPack, theme, question := createTestPackWithQuestion()
+	theme.Questions = append(theme.Questions, &pack.Question{ID: "q2", Price: 200, Text: "Next", Answer: "Next"})
+	manager := New(game, testPack, mockHub, mockLogger, mockRepo, mockCache, clock.NewFake(time.Unix(0, 0)))
+	defer manager.timer.Stop()
+	defer manager.scheduler.CancelAll()
+	game.CurrentRound = 1
+	game.UpdateStatus(domainGame.StatusRoundStart)
+
+	manager.transitionToQuestionSelect()
+	assert.Equal(t, domainGame.StatusQuestionSelect, game.Status)
+	assert.Equal(t, secondID, *game.ActivePlayer)
+
+	question.MarkAsUsed()
+	game.SetCurrentQuestion(question, theme.Name)
+	game.SetActivePlayer(firstID)
+	game.UpdateStatus(domainGame.StatusAnswerJudging)
+	manager.handlePlayerAction(&PlayerAction{
+		UserID:  hostID,
+		Message: &MockClientMessage{msgType: "JUDGE_ANSWER", payload: map[string]interface{}{"correct": true, "user_id": firstID.String()}},
+	})
+	assert.Equal(t, domainGame.StatusAnswerReveal, game.Status)
+	manager.finishAnswerReveal()
+	assert.Equal(t, domainGame.StatusQuestionSelect, game.Status)
+	assert.Equal(t, firstID, *game.ActivePlayer)
+
+	selectQ2 := func(userID uuid.UUID) {
+		manager.handlePlayerAction(&PlayerAction{
+			UserID:  userID,
+			Message: &MockClientMessage{msgType: "SELECT_QUESTION", payload: map[string]interface{}{"theme_id": "t1", "question_id": "q2"}},
+		})
+	}
+	selectQ2(hostID)
+	selectQ2(secondID)
+	assert.Equal(t, domainGame.StatusQuestionSelect, game.Status)
+	assert.Equal(t, []string{wsMessage.ErrorCodeNotYourTurn, wsMessage.ErrorCodeNotYourTurn}, codes)
+
+	selectQ2(firstID)
+	assert.Equal(t, domainGame.StatusQuestionShow, game.Status)
+	assert.Equal(t, "q2", game.CurrentQuestion.ID)
+}
+
+func TestManager_RoundRobinSelection(t *testing.T) {
+	game := createTestGame()
+	game.Settings.SelectionPolicy = domainGame.SelectionRoundRobin
+	hostID := uuid.New()
+	game.Players[hostID] = player.New(hostID, "host", "", player.RoleHost)
+	aliceID, bobID := uuid.New(), uuid.New()
+	game.Players[aliceID] = player.New(aliceID, "alice", "", player.RolePlayer)
+	game.Players[bobID] = player.New(bobID, "bob", "", player.RolePlayer)
+	var testID uuid.UUID
+	for userID, p := range game.Players {
+		if p.Username == "test-player" {
+			testID = userID
+		}
+	}
+	manager := New(game, createTestPack(), new(MockHub), new(MockEventLogger), newPermissiveRepository(), newPermissiveCache(), clock.Real())
+
+	order := []uuid.UUID{aliceID, bobID, testID, aliceID}
+	for _, expected := range order {
+		assert.Equal(t, expected, manager.chooseSelector())
+	}
+
+	game.Players[bobID].IsActive = false
+	assert.Equal(t, aliceID, manager.chooseSelector())
+
+	game.Settings.SelectionPolicy = domainGame.SelectionByHost
+	assert.Equal(t, hostID, manager.chooseSelector())
+}
+
+func TestManager_AnswerRevealShowsAnswerToEveryone(t *testing.T) {
+	game := createTestGame()
+	game.Settings.AnswerRevealMs = 2000
+	hostID := uuid.New()
+	game.Players[hostID] = player.New(hostID, "host", "", player.RoleHost)
+
+	mockHub := new(MockHub)
+	mockHub.On("Broadcast", game.ID, mock.Anything).Return().Maybe()
+	mockHub.On("BroadcastPersonalized", game.ID, mock.Anything).Return().Maybe()
+	mockHub.On("GetClientRTT", game.ID, mock.Anything).Return(time.Duration(0)).Maybe()
+	mockRepo := new(MockGameRepository)
+	mockRepo.On("UpdateGameSession", mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockCache := new(MockGameCache)
+	mockCache.On("SaveGameState", mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockLogger := new(MockEventLogger)
+	mockLogger.On("LogEvent", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	fake := clock.NewFake(time.Unix(0, 0))
+	testPack, theme, question := createTestPackWithQuestion()
+	question.Comment = "Pack comment"
+	question.AnswerMediaType = "image"
+	question.AnswerMediaURL = "http://example.com/answer.jpg"
+	theme.Questions = append(theme.Questions, &pack.Question{ID: "q2", Price: 200, Text: "Next", Answer: "Next"})
+	manager := New(game, testPack, mockHub, mockLogger, mockRepo, mockCache, fake)
+	defer manager.timer.Stop()
+	defer manager.scheduler.CancelAll()
+	game.CurrentRound = 1
+	game.UpdateStatus(domainGame.StatusQuestionSelect)
+
+	manager.selectQuestion(theme, question)
+	manager.skipQuestion()
+	assert.Equal(t, domainGame.StatusAnswerReveal, game.Status)
+
+	spectatorView := manager.buildGameState().ForAudience(domainGame.AudienceSpectator)
+	if assert.NotNil(t, spectatorView.AnswerReveal) {
+		assert.Equal(t, "Secret answer", spectatorView.AnswerReveal.Answer)
+		assert.Equal(t, "Pack comment", spectatorView.AnswerReveal.Comment)
+		assert.Equal(t, "http://example.com/answer.jpg", spectatorView.AnswerReveal.MediaURL)
+		assert.Empty(t, spectatorView.AnswerReveal.ScoreChanges)
+	}
+	assert.Equal(t, "Secret answer", spectatorView.CurrentQuestion.Answer)
+
+	fake.Advance(time.Second)
+	select {
+	case <-manager.timer.C:
+		t.Fatal("reveal ended before the configured duration")
+	default:
+	}
+
+	fake.Advance(time.Second)
+	select {
+	case <-manager.timer.C:
+		manager.handleTimeout()
+	case <-time.After(time.Second):
+		t.Fatal("reveal timer did not fire")
+	}
+	assert.Equal(t, domainGame.StatusQuestionSelect, game.Status)
+	assert.Nil(t, game.CurrentQuestion)
+	assert.Nil(t, manager.buildGameState().AnswerReveal)
+}
+
+func TestManager_ScoringRulesAllowNegativeScores(t *testing.T) {
+	game := createTestGame()
+	game.Settings.Scoring = domainGame.ScoringRules{AllowNegative: true, RoundMultipliers: []float64{2}}
+	var playerID uuid.UUID
+	for userID, p := range game.Players {
+		playerID = userID
+		p.Score = 100
+	}
+	richID := uuid.New()
+	game.Players[richID] = player.New(richID, "rich", "", player.RolePlayer)
+	game.Players[richID].Score = 800
+
+	mockHub := new(MockHub)
+	mockHub.On("Broadcast", game.ID, mock.Anything).Return().Maybe()
+	mockHub.On("BroadcastPersonalized", game.ID, mock.Anything).Return().Maybe()
+	mockHub.On("BroadcastToUser", game.ID, mock.Anything, mock.Anything).Return().Maybe()
+	mockRepo := new(MockGameRepository)
+	mockRepo.On("UpdateGameSession", mock.Anything, mock.Anything).Return(nil).Maybe()
+	mockCache := new(MockGameCache)
+	mockCache.On("SaveGameState", mock.Anything, mock.Anything).Return(nil).Maybe()
+
+	testPack, theme, question := createTestPackWithQuestion()
+	question.Type = pack.TypeForAll
+	stakeQuestion := &pack.Question{ID: "q2", Price: 300, Text: "Stake", Answer: "Stake", Type: pack.TypeStake}
+	theme.Questions = append(theme.Questions, stakeQuestion)
+	manager := New(game, testPack, mockHub, new(MockEventLogger), mockRepo, mockCache, clock.NewFake(time.Unix(0, 0)))
+	defer manager.timer.Stop()
+	defer manager.scheduler.CancelAll()
+	game.CurrentRound = 1
+	game.SetCurrentQuestion(question, theme.Name)
+	manager.forAllCollector.Start(question.Answer, question.Price)
+	game.UpdateStatus(domainGame.StatusForAllAnswering)
+
+	for _, userID := range []uuid.UUID{playerID, richID} {
+		manager.handlePlayerAction(&PlayerAction{
+			UserID:  userID,
+			Message: &MockClientMessage{msgType: "SUBMIT_FOR_ALL_ANSWER", payload: map[string]interface{}{"answer": "wrong"}},
+		})
+	}
+	assert.Equal(t, domainGame.StatusForAllResults, game.Status)
+	assert.Equal(t, 100-2*question.Price, game.Players[playerID].Score)
+	assert.Equal(t, 800-2*question.Price, game.Players[richID].Score)
+	for _, result := range manager.forAllResults {
+		assert.Equal(t, -2*question.Price, result.ScoreDelta)
+	}
+
+	manager.clearQuestion()
+	game.SetActivePlayer(playerID)
+	game.UpdateStatus(domainGame.StatusQuestionSelect)
+	manager.selectQuestion(theme, stakeQuestion)
+	assert.Equal(t, domainGame.StatusStakeBetting, game.Status)
+	assert.Equal(t, 600, manager.stakeInfo.MaxBet)
+	for _, bid := range manager.stakeInfo.Bids {
+		if bid.UserID == playerID {
+			assert.Equal(t, stakeQuestion.Price, bid.Limit)
+		}
+	}
+	assert.Equal(t, playerID, *manager.stakeInfo.CurrentTurn)
+}
@@ -0,0 +1,175 @@
+package game
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"sigame/game/internal/domain/event"
+	domainGame "sigame/game/internal/domain/game"
+	"sigame/game/internal/domain/player"
+	"sigame/game/internal/infrastructure/logger"
+	wsMessage "sigame/game/internal/transport/ws/message"
+)
+
+func init() {
+	registerAction(wsMessage.MessageTypeAdjustScore, (*Manager).handleAdjustScore)
+	registerAction(wsMessage.MessageTypeKickPlayer, (*Manager).handleKickPlayer)
+	registerAction(wsMessage.MessageTypeSkipQuestion, withoutPayload((*Manager).handleSkipQuestion))
+	registerAction(wsMessage.MessageTypeEndRound, withoutPayload((*Manager).handleEndRound))
+}
+
+func (m *Manager) isHost(userID uuid.UUID) bool {
+	p, ok := m.game.Players[userID]
+	return ok && p.Role == player.RoleHost
+}
+
+func (m *Manager) moderationTarget(userID uuid.UUID) (*player.Player, bool) {
+	p, ok := m.game.Players[userID]
+	if !ok || !p.Role.IsPlayer() {
+		return nil, false
+	}
+	return p, true
+}
+
+func (m *Manager) logModeration(eventType event.Type, hostID uuid.UUID) *event.Event {
+	evt := event.New(m.game.ID, eventType).
+		WithUser(hostID).
+		WithRound(m.game.CurrentRound)
+	if m.game.CurrentQuestion != nil {
+		evt.WithQuestion(m.game.CurrentQuestion.ID)
+	}
+	return evt
+}
+
+func (m *Manager) handleAdjustScore(action *PlayerAction, payload *wsMessage.AdjustScorePayload) {
+	if !m.isHost(action.UserID) {
+		logger.Warnf(m.ctx, "[ADJUST_SCORE] User is not host: %s", action.UserID)
+		m.reject(action, wsMessage.ErrorCodeNotHost, "only the host can do this")
+		return
+	}
+
+	target, ok := m.moderationTarget(payload.UserID)
+	if !ok {
+		logger.Warnf(m.ctx, "[ADJUST_SCORE] Invalid target: %s", payload.UserID)
+		m.reject(action, wsMessage.ErrorCodeInvalidPayload, "user_id must reference a player")
+		return
+	}
+
+	delta := payload.Delta
+	before := target.Score
+	applied := m.scorer.Adjust(target, delta)
+
+	evt := m.logModeration(event.TypeScoreAdjusted, action.UserID).
+		WithData("target_user_id", target.UserID.String()).
+		WithData("delta", delta).
+		WithData("applied_delta", applied).
+		WithData("score_before", before).
+		WithData("score_after", target.Score)
+	m.eventLogger.LogEvent(context.Background(), evt)
+
+	logger.Infof(m.ctx, "[ADJUST_SCORE] Host %s adjusted score of %s by %d: %d -> %d", action.UserID, target.UserID, delta, before, target.Score)
+	m.BroadcastState()
+}
+
+func (m *Manager) handleKickPlayer(action *PlayerAction, payload *wsMessage.KickPlayerPayload) {
+	if !m.isHost(action.UserID) {
+		logger.Warnf(m.ctx, "[KICK_PLAYER] User is not host: %s", action.UserID)
+		m.reject(action, wsMessage.ErrorCodeNotHost, "only the host can do this")
+		return
+	}
+
+	target, ok := m.moderationTarget(payload.UserID)
+	if !ok || !target.IsActive {
+		logger.Warnf(m.ctx, "[KICK_PLAYER] Invalid target: %s", payload.UserID)
+		m.reject(action, wsMessage.ErrorCodeInvalidPayload, "user_id must reference a player")
+		return
+	}
+
+	target.Leave()
+	m.buttonPress.Withdraw(target.UserID)
+
+	evt := m.logModeration(event.TypePlayerKicked, action.UserID).
+		WithData("target_user_id", target.UserID.String()).
+		WithData("score", target.Score)
+	m.eventLogger.LogEvent(context.Background(), evt)
+
+	logger.Infof(m.ctx, "[KICK_PLAYER] Host %s kicked player %s (%s) in status %s", action.UserID, target.UserID, target.Username, m.game.Status)
+
+	if m.game.Status == domainGame.StatusStakeBetting && m.stakeInfo != nil {
+		if turnPlayer, ok := m.stakeInfo.TurnPlayer(); ok && turnPlayer == target.UserID {
+			m.passStake(target.UserID)
+			return
+		}
+	}
+
+	if m.isActivePlayer(target.UserID) && m.questionInPlay() && m.game.Status != domainGame.StatusSecretTransfer {
+		m.timer.Stop()
+		m.continueGame()
+		return
+	}
+
+	m.BroadcastState()
+}
+
+func (m *Manager) handleSkipQuestion(action *PlayerAction) {
+	if !m.isHost(action.UserID) {
+		logger.Warnf(m.ctx, "[SKIP_QUESTION] User is not host: %s", action.UserID)
+		m.reject(action, wsMessage.ErrorCodeNotHost, "only the host can do this")
+		return
+	}
+
+	if m.game.Status == domainGame.StatusAnswerReveal {
+		logger.Infof(m.ctx, "[SKIP_QUESTION] Host %s skipped answer reveal for question %s", action.UserID, m.game.CurrentQuestion.ID)
+		m.finishAnswerReveal()
+		return
+	}
+
+	if !m.questionInPlay() {
+		logger.Warnf(m.ctx, "[SKIP_QUESTION] No question in play, status: %s", m.game.Status)
+		m.reject(action, wsMessage.ErrorCodeInvalidPhase, "no question is in play")
+		return
+	}
+
+	evt := m.logModeration(event.TypeQuestionSkipped, action.UserID).
+		WithData("status", m.game.Status.String())
+	m.eventLogger.LogEvent(context.Background(), evt)
+
+	logger.Infof(m.ctx, "[SKIP_QUESTION] Host %s skipped question %s in status %s", action.UserID, m.game.CurrentQuestion.ID, m.game.Status)
+	m.timer.Stop()
+	m.continueGame()
+}
+
+func (m *Manager) handleEndRound(action *PlayerAction) {
+	if !m.isHost(action.UserID) {
+		logger.Warnf(m.ctx, "[END_ROUND] User is not host: %s", action.UserID)
+		m.reject(action, wsMessage.ErrorCodeNotHost, "only the host can do this")
+		return
+	}
+
+	switch m.game.Status {
+	case domainGame.StatusWaiting, domainGame.StatusRoundsOverview, domainGame.StatusRoundEnd,
+		domainGame.StatusGameEnd, domainGame.StatusFinished, domainGame.StatusCancelled:
+		logger.Warnf(m.ctx, "[END_ROUND] Cannot end round in status: %s", m.game.Status)
+		m.reject(action, wsMessage.ErrorCodeInvalidPhase, "no round is in progress")
+		return
+	}
+
+	evt := m.logModeration(event.TypeRoundEndedByHost, action.UserID).
+		WithData("status", m.game.Status.String())
+	m.eventLogger.LogEvent(context.Background(), evt)
+
+	logger.Infof(m.ctx, "[END_ROUND] Host %s ended round %d in status %s", action.UserID, m.game.CurrentRound, m.game.Status)
+	m.timer.Stop()
+	m.clearQuestion()
+	m.endRound()
+}
+
+func (m *Manager) isActivePlayer(userID uuid.UUID) bool {
+	return m.game.ActivePlayer != nil && *m.game.ActivePlayer == userID
+}
+
+func (m *Manager) questionInPlay() bool {
+	return m.game.CurrentQuestion != nil && !m.game.Status.IsFinalRound() &&
+		m.game.Status != domainGame.StatusQuestionSelect && m.game.Status != domainGame.StatusRoundEnd &&
+		m.game.Status != domainGame.StatusAnswerReveal
+}
@@ -0,0 +1,72 @@
+package game
+
+import (
+	"context"
+
+	"sigame/game/internal/domain/event"
+	"sigame/game/internal/infrastructure/logger"
+	wsMessage "sigame/game/internal/transport/ws/message"
+)
+
+func init() {
+	registerAction(wsMessage.MessageTypePauseGame, withoutPayload((*Manager).handlePauseGame))
+	registerAction(wsMessage.MessageTypeResumeGame, withoutPayload((*Manager).handleResumeGame))
+}
+
+func allowedWhilePaused(msgType string) bool {
+	switch wsMessage.MessageType(msgType) {
+	case wsMessage.MessageTypeReady, wsMessage.MessageTypeResumeGame, wsMessage.MessageTypeMediaLoadProgress, wsMessage.MessageTypeMediaLoadComplete:
+		return true
+	}
+	return false
+}
+
+func (m *Manager) handlePauseGame(action *PlayerAction) {
+	if !m.isHost(action.UserID) {
+		logger.Warnf(m.ctx, "[PAUSE_GAME] User is not host: %s", action.UserID)
+		m.reject(action, wsMessage.ErrorCodeNotHost, "only the host can do this")
+		return
+	}
+
+	if err := m.game.Pause(); err != nil {
+		logger.Warnf(m.ctx, "[PAUSE_GAME] Cannot pause game in status %s: %v", m.game.Status, err)
+		m.rejectErr(action, err)
+		return
+	}
+
+	m.timer.Pause()
+	m.scheduler.Pause()
+
+	evt := event.New(m.game.ID, event.TypeGamePaused).WithUser(action.UserID)
+	m.eventLogger.LogEvent(context.Background(), evt)
+
+	logger.Infof(m.ctx, "[PAUSE_GAME] Game paused by host %s, status: %s, remaining: %ds", action.UserID, m.game.Status, m.timer.Remaining())
+	m.BroadcastState()
+}
+
+func (m *Manager) handleResumeGame(action *PlayerAction) {
+	if !m.isHost(action.UserID) {
+		logger.Warnf(m.ctx, "[RESUME_GAME] User is not host: %s", action.UserID)
+		m.reject(action, wsMessage.ErrorCodeNotHost, "only the host can do this")
+		return
+	}
+
+	if err := m.game.Resume(); err != nil {
+		logger.Warnf(m.ctx, "[RESUME_GAME] Cannot resume game: %v", err)
+		m.rejectErr(action, err)
+		return
+	}
+
+	m.timer.Resume()
+	due := m.scheduler.Resume()
+
+	evt := event.New(m.game.ID, event.TypeGameResumed).WithUser(action.UserID)
+	m.eventLogger.LogEvent(context.Background(), evt)
+
+	logger.Infof(m.ctx, "[RESUME_GAME] Game resumed by host %s, status: %s", action.UserID, m.game.Status)
+	m.BroadcastState()
+
+	for _, ev := range due {
+		m.runScheduledEvent(ev)
+	}
+}
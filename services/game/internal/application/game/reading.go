@@ -0,0 +1,101 @@
+package game
+
+import (
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"sigame/game/internal/domain/pack"
+	"sigame/game/internal/infrastructure/logger"
+	wsMessage "sigame/game/internal/transport/ws/message"
+)
+
+type readingProgress struct {
+	questionID string
+	totalChars int
+	textTime   time.Duration
+	readTime   time.Duration
+	elapsed    time.Duration
+}
+
+func (r *readingProgress) revealedChars() int {
+	if r.textTime <= 0 || r.elapsed >= r.textTime {
+		return r.totalChars
+	}
+	return int(int64(r.totalChars) * int64(r.elapsed) / int64(r.textTime))
+}
+
+func (m *Manager) textReadTime(text string) time.Duration {
+	chars := utf8.RuneCountInString(strings.TrimSpace(text))
+	readTime := time.Duration(chars) * time.Second / time.Duration(m.game.Settings.GetReadingSpeed())
+	if readTime < MinQuestionReadDuration {
+		readTime = MinQuestionReadDuration
+	}
+	return readTime
+}
+
+func (m *Manager) questionReadTime(question *pack.Question) time.Duration {
+	readTime := m.textReadTime(question.Text)
+	if question.MediaDurationMs > 0 {
+		readTime += time.Duration(question.MediaDurationMs) * time.Millisecond
+	}
+	return readTime
+}
+
+func (m *Manager) startReading(question *pack.Question) time.Duration {
+	if question.HasMedia() {
+		m.sendStartMedia(question)
+	}
+
+	readTime := m.questionReadTime(question)
+	m.reading = &readingProgress{
+		questionID: question.ID,
+		totalChars: utf8.RuneCountInString(strings.TrimSpace(question.Text)),
+		textTime:   m.textReadTime(question.Text),
+		readTime:   readTime,
+	}
+
+	logger.Infof(m.ctx, "[startReading] Question %s: %d chars, text time %v, read time %v", question.ID, m.reading.totalChars, m.reading.textTime, readTime)
+	m.broadcastReadProgress()
+	m.schedule(tagReadProgress, ReadProgressInterval, m.tickReadProgress)
+
+	return readTime
+}
+
+func (m *Manager) tickReadProgress() {
+	if m.reading == nil {
+		return
+	}
+
+	m.reading.elapsed += ReadProgressInterval
+	if m.reading.elapsed > m.reading.textTime {
+		m.reading.elapsed = m.reading.textTime
+	}
+	m.broadcastReadProgress()
+
+	if m.reading.elapsed < m.reading.textTime {
+		m.schedule(tagReadProgress, ReadProgressInterval, m.tickReadProgress)
+	}
+}
+
+func (m *Manager) finishReading() {
+	m.scheduler.Cancel(tagReadProgress)
+	if m.reading == nil || m.reading.elapsed >= m.reading.textTime {
+		return
+	}
+
+	m.reading.elapsed = m.reading.textTime
+	m.broadcastReadProgress()
+}
+
+func (m *Manager) broadcastReadProgress() {
+	msg := wsMessage.NewQuestionRevealMessage(
+		m.reading.questionID,
+		m.reading.revealedChars(),
+		m.reading.totalChars,
+		m.reading.elapsed.Milliseconds(),
+		m.reading.readTime.Milliseconds(),
+	)
+	msg.Transient = m.reading.elapsed < m.reading.textTime
+	m.hub.Broadcast(m.game.ID, msg)
+}
@@ -0,0 +1,35 @@
+package game
+
+import (
+	"errors"
+
+	domainGame "sigame/game/internal/domain/game"
+	wsMessage "sigame/game/internal/transport/ws/message"
+)
+
+func (m *Manager) reject(action *PlayerAction, code, message string) {
+	msg := wsMessage.NewReplyErrorMessage(message, code, action.Message.GetID())
+	m.hub.BroadcastToUser(m.game.ID, action.UserID, msg)
+}
+
+func (m *Manager) rejectErr(action *PlayerAction, err error) {
+	m.reject(action, errorCode(err), err.Error())
+}
+
+func errorCode(err error) string {
+	switch {
+	case errors.Is(err, domainGame.ErrNotStakeTurn), errors.Is(err, domainGame.ErrNotFinalTurn):
+		return wsMessage.ErrorCodeNotYourTurn
+	case errors.Is(err, domainGame.ErrStakeTooLow), errors.Is(err, domainGame.ErrStakeTooHigh):
+		return wsMessage.ErrorCodeInvalidStake
+	case errors.Is(err, domainGame.ErrFinalStakePlaced), errors.Is(err, domainGame.ErrFinalAnswerSubmitted),
+		errors.Is(err, domainGame.ErrFinalAnswerJudged), errors.Is(err, domainGame.ErrFinalThemeRemoved):
+		return wsMessage.ErrorCodeAlreadySubmitted
+	case errors.Is(err, domainGame.ErrGameNotRunning), errors.Is(err, domainGame.ErrGameAlreadyPaused),
+		errors.Is(err, domainGame.ErrGameNotPaused):
+		return wsMessage.ErrorCodeInvalidPhase
+	case errors.Is(err, domainGame.ErrPlayerNotFound):
+		return wsMessage.ErrorCodePlayerNotFound
+	}
+	return wsMessage.ErrorCodeNotAllowed
+}
@@ -0,0 +1,70 @@
+package game
+
+import (
+	"sort"
+
+	"github.com/google/uuid"
+	domainGame "sigame/game/internal/domain/game"
+	"sigame/game/internal/infrastructure/logger"
+)
+
+func (m *Manager) snapshotScores() {
+	m.scoresBefore = make(map[uuid.UUID]int, len(m.game.Players))
+	for userID, p := range m.game.Players {
+		m.scoresBefore[userID] = p.Score
+	}
+}
+
+func (m *Manager) questionScoreChanges() []domainGame.ScoreChange {
+	changes := make([]domainGame.ScoreChange, 0)
+	for userID, p := range m.game.Players {
+		delta := p.Score - m.scoresBefore[userID]
+		if delta == 0 {
+			continue
+		}
+		changes = append(changes, domainGame.ScoreChange{
+			UserID:   userID,
+			Username: p.Username,
+			Delta:    delta,
+			Score:    p.Score,
+		})
+	}
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Username < changes[j].Username
+	})
+	return changes
+}
+
+func (m *Manager) revealAnswer() {
+	m.timer.Stop()
+	m.finishReading()
+	question := m.game.CurrentQuestion
+
+	m.answerReveal = &domainGame.AnswerReveal{
+		QuestionID:   question.ID,
+		Answer:       question.Answer,
+		AltAnswers:   question.AltAnswers,
+		Comment:      question.Comment,
+		ScoreChanges: m.questionScoreChanges(),
+	}
+	if question.HasAnswerMedia() {
+		m.answerReveal.MediaType = question.AnswerMediaType
+		m.answerReveal.MediaURL = question.AnswerMediaURL
+	}
+
+	if !m.transition(domainGame.StatusAnswerReveal) {
+		m.finishAnswerReveal()
+		return
+	}
+	m.BroadcastState()
+
+	duration := m.game.Settings.GetAnswerRevealDuration()
+	m.timer.Start(duration)
+	logger.Infof(m.ctx, "[revealAnswer] Question %s: revealing answer for %v, %d score changes", question.ID, duration, len(m.answerReveal.ScoreChanges))
+}
+
+func (m *Manager) finishAnswerReveal() {
+	m.timer.Stop()
+	m.nextQuestion()
+}
@@ -0,0 +1,175 @@
+package game
+
+import (
+	"sync"
+	"time"
+
+	"sigame/game/internal/core/clock"
+	"sigame/game/internal/core/timer"
+	domainGame "sigame/game/internal/domain/game"
+	"sigame/game/internal/infrastructure/logger"
+)
+
+type scheduledTag string
+
+const (
+	tagButtonPressCollection scheduledTag = "button_press_collection"
+	tagForAllRead            scheduledTag = "for_all_read"
+	tagRoundEnd              scheduledTag = "round_end"
+	tagReadProgress          scheduledTag = "read_progress"
+)
+
+type scheduledEvent struct {
+	tag       scheduledTag
+	status    domainGame.Status
+	timer     *timer.Timer
+	fn        func()
+	due       bool
+	cancelled chan struct{}
+}
+
+type scheduler struct {
+	C      chan *scheduledEvent
+	events map[scheduledTag]*scheduledEvent
+	paused bool
+	clock  clock.Clock
+	mu     sync.Mutex
+}
+
+func newScheduler(clk clock.Clock) *scheduler {
+	return &scheduler{
+		C:      make(chan *scheduledEvent, SchedulerChannelBuffer),
+		events: make(map[scheduledTag]*scheduledEvent),
+		clock:  clk,
+	}
+}
+
+func (s *scheduler) Schedule(tag scheduledTag, status domainGame.Status, delay time.Duration, fn func()) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.cancelInternal(tag)
+
+	ev := &scheduledEvent{
+		tag:       tag,
+		status:    status,
+		timer:     timer.New(s.clock),
+		fn:        fn,
+		cancelled: make(chan struct{}),
+	}
+	ev.timer.Start(delay)
+	if s.paused {
+		ev.timer.Pause()
+	}
+	s.events[tag] = ev
+
+	go s.watch(ev)
+}
+
+func (s *scheduler) watch(ev *scheduledEvent) {
+	select {
+	case <-ev.timer.C:
+		select {
+		case s.C <- ev:
+		case <-ev.cancelled:
+		}
+	case <-ev.cancelled:
+	}
+}
+
+func (s *scheduler) Cancel(tags ...scheduledTag) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, tag := range tags {
+		s.cancelInternal(tag)
+	}
+}
+
+func (s *scheduler) CancelAll() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for tag := range s.events {
+		s.cancelInternal(tag)
+	}
+}
+
+func (s *scheduler) cancelInternal(tag scheduledTag) {
+	ev, ok := s.events[tag]
+	if !ok {
+		return
+	}
+
+	ev.timer.Stop()
+	close(ev.cancelled)
+	delete(s.events, tag)
+}
+
+func (s *scheduler) Pause() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.paused = true
+	for _, ev := range s.events {
+		ev.timer.Pause()
+	}
+}
+
+func (s *scheduler) Resume() []*scheduledEvent {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.paused = false
+	due := make([]*scheduledEvent, 0)
+	for _, ev := range s.events {
+		if ev.due {
+			due = append(due, ev)
+			continue
+		}
+		ev.timer.Resume()
+	}
+	return due
+}
+
+func (s *scheduler) take(ev *scheduledEvent) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.events[ev.tag] != ev {
+		return false
+	}
+	if s.paused {
+		ev.due = true
+		return false
+	}
+
+	delete(s.events, ev.tag)
+	return true
+}
+
+func (m *Manager) schedule(tag scheduledTag, delay time.Duration, fn func()) {
+	logger.Infof(m.ctx, "[schedule] Scheduling %s in %v, status: %s", tag, delay, m.game.Status)
+	m.scheduler.Schedule(tag, m.game.Status, delay, fn)
+}
+
+func (m *Manager) handleScheduledEvent(ev *scheduledEvent) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.runScheduledEvent(ev)
+}
+
+func (m *Manager) runScheduledEvent(ev *scheduledEvent) {
+	if !m.scheduler.take(ev) {
+		logger.Infof(m.ctx, "[scheduler] Event %s is stale or deferred by pause", ev.tag)
+		return
+	}
+
+	if m.game.Status != ev.status {
+		logger.Warnf(m.ctx, "[scheduler] Dropping %s scheduled in %s, phase changed to %s", ev.tag, ev.status, m.game.Status)
+		return
+	}
+
+	ev.fn()
+}
@@ -4,9 +4,31 @@ import (
 	"sort"
 
 	"sigame/game/internal/core/scoring"
+	"sigame/game/internal/domain/pack"
 	"sigame/game/internal/domain/player"
 )
 
+func (m *Manager) questionValue() int {
+	return m.scorer.QuestionValue(m.game.CurrentQuestion.Price, m.game.CurrentRound)
+}
+
+func (m *Manager) scoreAnswer(p *player.Player, correct bool) int {
+	if m.game.CurrentQuestion.GetType() == pack.TypeStake {
+		return m.scorer.Stake(p, correct, m.game.CurrentQuestion.Price)
+	}
+	if correct {
+		return m.scorer.Correct(p, m.questionValue())
+	}
+	return m.scorer.Wrong(p, m.questionValue())
+}
+
+func (m *Manager) scoreTimeout(p *player.Player) int {
+	if m.game.CurrentQuestion.GetType() == pack.TypeStake {
+		return m.scorer.StakeTimeout(p, m.game.CurrentQuestion.Price)
+	}
+	return m.scorer.Timeout(p, m.questionValue())
+}
+
 func (m *Manager) calculateWinners() []player.Score {
 	scores := m.calculateFinalScores()
 
@@ -25,7 +47,7 @@ func (m *Manager) calculateFinalScores() []player.Score {
 	scores := make([]player.Score, 0)
 
 	for userID, p := range m.game.Players {
-		if p.Role == player.RoleHost {
+		if !p.Role.IsPlayer() {
 			continue
 		}
 		scores = append(scores, player.NewScore(userID, p.Username, p.Score))
@@ -0,0 +1,196 @@
+package game
+
+import (
+	"sort"
+
+	"github.com/google/uuid"
+	domainGame "sigame/game/internal/domain/game"
+	"sigame/game/internal/domain/pack"
+	"sigame/game/internal/domain/player"
+	"sigame/game/internal/infrastructure/logger"
+	wsMessage "sigame/game/internal/transport/ws/message"
+)
+
+func init() {
+	registerAction(wsMessage.MessageTypeTransferSecret, (*Manager).handleTransferSecret)
+	registerAction(wsMessage.MessageTypeSelectSecretPrice, (*Manager).handleSelectSecretPrice)
+}
+
+func (m *Manager) startSecretQuestion(question *pack.Question) {
+	logger.Infof(m.ctx, "[startSecretQuestion] Starting secret question, price: %d", question.Price)
+
+	chooserID := m.findHost()
+	if m.game.ActivePlayer != nil {
+		chooserID = *m.game.ActivePlayer
+	}
+
+	theme := ""
+	if m.game.CurrentTheme != nil {
+		theme = *m.game.CurrentTheme
+	}
+
+	mode := question.SecretParams.GetSelectionMode()
+	m.secretInfo = &domainGame.SecretInfo{
+		Theme:         question.SecretParams.GetTheme(theme),
+		ChooserID:     chooserID,
+		SelectionMode: mode.String(),
+		Candidates:    m.secretCandidates(chooserID, mode),
+		AllowedPrices: question.SecretParams.AllowedPrices(question.Price),
+	}
+
+	m.game.SetActivePlayer(chooserID)
+	if !m.transition(domainGame.StatusSecretTransfer) {
+		return
+	}
+	m.BroadcastState()
+	m.timer.Start(m.game.Settings.GetSecretTransferDuration())
+	logger.Infof(m.ctx, "[startSecretQuestion] Status changed to: %s, chooser: %s, mode: %s, timer started for %v", m.game.Status, chooserID, mode, m.game.Settings.GetSecretTransferDuration())
+}
+
+func (m *Manager) secretCandidates(chooserID uuid.UUID, mode pack.SecretSelectionMode) []uuid.UUID {
+	contestants := make([]*player.Player, 0)
+	for _, p := range m.game.Players {
+		if p.Role.IsPlayer() && p.IsActive {
+			contestants = append(contestants, p)
+		}
+	}
+
+	sort.Slice(contestants, func(i, j int) bool {
+		return contestants[i].Username < contestants[j].Username
+	})
+
+	candidates := make([]uuid.UUID, 0, len(contestants))
+	for _, p := range contestants {
+		if mode == pack.SecretSelectionExceptCurrent && p.UserID == chooserID {
+			continue
+		}
+		candidates = append(candidates, p.UserID)
+	}
+
+	if len(candidates) == 0 && len(contestants) > 0 {
+		candidates = append(candidates, contestants[0].UserID)
+	}
+
+	return candidates
+}
+
+func (m *Manager) isSecretCandidate(userID uuid.UUID) bool {
+	for _, candidate := range m.secretInfo.Candidates {
+		if candidate == userID {
+			return true
+		}
+	}
+	return false
+}
+
+func (m *Manager) handleTransferSecret(action *PlayerAction, payload *wsMessage.TransferSecretPayload) {
+	logger.Infof(m.ctx, "[TRANSFER_SECRET] Received from user: %s, game status: %s", action.UserID, m.game.Status)
+	if m.game.Status != domainGame.StatusSecretTransfer || m.secretInfo == nil {
+		logger.Warnf(m.ctx, "[TRANSFER_SECRET] Invalid game status: %s, expected: %s", m.game.Status, domainGame.StatusSecretTransfer)
+		m.reject(action, wsMessage.ErrorCodeInvalidPhase, "no secret question is being transferred")
+		return
+	}
+
+	sender := m.game.Players[action.UserID]
+	if action.UserID != m.secretInfo.ChooserID && sender.Role != player.RoleHost {
+		logger.Warnf(m.ctx, "[TRANSFER_SECRET] User is not the chooser: %s, chooser: %s", action.UserID, m.secretInfo.ChooserID)
+		m.reject(action, wsMessage.ErrorCodeNotYourTurn, "only the chooser can transfer the secret question")
+		return
+	}
+
+	targetUserID := payload.TargetUserID
+	if !m.isSecretCandidate(targetUserID) {
+		logger.Warnf(m.ctx, "[TRANSFER_SECRET] Target %s is not allowed by selection mode %s", targetUserID, m.secretInfo.SelectionMode)
+		m.reject(action, wsMessage.ErrorCodeNotAllowed, "the question cannot be transferred to this player")
+		return
+	}
+
+	m.transferSecretToPlayer(action.UserID, targetUserID)
+}
+
+func (m *Manager) handleSecretTransferTimeout() {
+	if m.secretInfo == nil || len(m.secretInfo.Candidates) == 0 {
+		m.continueGame()
+		return
+	}
+
+	m.transferSecretToPlayer(m.secretInfo.ChooserID, m.secretInfo.Candidates[0])
+}
+
+func (m *Manager) transferSecretToPlayer(fromUserID, toUserID uuid.UUID) {
+	m.timer.Stop()
+	m.game.SetActivePlayer(toUserID)
+	m.secretTarget = &toUserID
+
+	m.broadcastSecretTransferred(fromUserID, toUserID)
+
+	if len(m.secretInfo.AllowedPrices) > 1 {
+		if !m.transition(domainGame.StatusSecretPriceSelect) {
+			return
+		}
+		m.BroadcastState()
+		m.timer.Start(m.game.Settings.GetSecretPriceSelectDuration())
+		return
+	}
+
+	m.setSecretPrice(m.secretInfo.AllowedPrices[0])
+}
+
+func (m *Manager) broadcastSecretTransferred(fromUserID, toUserID uuid.UUID) {
+	fromUsername := ""
+	if p, ok := m.game.Players[fromUserID]; ok {
+		fromUsername = p.Username
+	}
+	toUsername := ""
+	if p, ok := m.game.Players[toUserID]; ok {
+		toUsername = p.Username
+	}
+
+	msg := wsMessage.NewSecretTransferredMessage(fromUserID, fromUsername, toUserID, toUsername, m.secretInfo.Theme, m.secretInfo.AllowedPrices)
+	m.hub.Broadcast(m.game.ID, msg)
+}
+
+func (m *Manager) handleSelectSecretPrice(action *PlayerAction, payload *wsMessage.SelectSecretPricePayload) {
+	if m.game.Status != domainGame.StatusSecretPriceSelect || m.secretInfo == nil {
+		logger.Warnf(m.ctx, "[SELECT_SECRET_PRICE] Invalid game status: %s, expected: %s", m.game.Status, domainGame.StatusSecretPriceSelect)
+		m.reject(action, wsMessage.ErrorCodeInvalidPhase, "no secret price is being selected")
+		return
+	}
+
+	if m.game.ActivePlayer == nil || *m.game.ActivePlayer != action.UserID {
+		logger.Warnf(m.ctx, "[SELECT_SECRET_PRICE] User is not the receiver: %s, active: %v", action.UserID, m.game.ActivePlayer)
+		m.reject(action, wsMessage.ErrorCodeNotYourTurn, "only the receiver can select the price")
+		return
+	}
+
+	price := payload.Price
+	if !m.game.CurrentQuestion.SecretParams.IsAllowedPrice(m.game.CurrentQuestion.Price, price) {
+		logger.Warnf(m.ctx, "[SELECT_SECRET_PRICE] Price %d is not allowed: %v", price, m.secretInfo.AllowedPrices)
+		m.reject(action, wsMessage.ErrorCodeInvalidPayload, "price is not allowed")
+		return
+	}
+
+	m.setSecretPrice(int(price))
+}
+
+func (m *Manager) handleSecretPriceTimeout() {
+	if m.secretInfo == nil {
+		m.continueGame()
+		return
+	}
+
+	m.setSecretPrice(m.secretInfo.AllowedPrices[0])
+}
+
+func (m *Manager) setSecretPrice(price int) {
+	m.timer.Stop()
+	m.secretInfo.Price = price
+	m.game.CurrentQuestion.Price = price
+
+	if !m.transition(domainGame.StatusQuestionShow) {
+		return
+	}
+	m.BroadcastState()
+
+	m.timer.Start(m.startReading(m.game.CurrentQuestion))
+}
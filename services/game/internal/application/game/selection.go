@@ -0,0 +1,67 @@
+package game
+
+import (
+	"sort"
+
+	"github.com/google/uuid"
+	domainGame "sigame/game/internal/domain/game"
+	"sigame/game/internal/domain/player"
+	"sigame/game/internal/infrastructure/logger"
+)
+
+func (m *Manager) chooseSelector() uuid.UUID {
+	switch m.game.Settings.GetSelectionPolicy() {
+	case domainGame.SelectionByLastCorrect:
+		if m.lastCorrect != nil {
+			if p, ok := m.game.Players[*m.lastCorrect]; ok && p.CanPressButton() {
+				return p.UserID
+			}
+		}
+		if selector := m.selectActivePlayer(); selector != uuid.Nil {
+			return selector
+		}
+	case domainGame.SelectionRoundRobin:
+		if selector := m.nextRoundRobinSelector(); selector != uuid.Nil {
+			return selector
+		}
+	}
+
+	return m.findHost()
+}
+
+func (m *Manager) nextRoundRobinSelector() uuid.UUID {
+	contestants := make([]*player.Player, 0)
+	for _, p := range m.game.Players {
+		if p.CanPressButton() {
+			contestants = append(contestants, p)
+		}
+	}
+	if len(contestants) == 0 {
+		return uuid.Nil
+	}
+
+	sort.Slice(contestants, func(i, j int) bool {
+		return contestants[i].Username < contestants[j].Username
+	})
+
+	selector := contestants[m.selectionTurn%len(contestants)]
+	m.selectionTurn++
+	return selector.UserID
+}
+
+func (m *Manager) startSelectionTurn() {
+	selector := m.chooseSelector()
+	m.game.SetActivePlayer(selector)
+	logger.Infof(m.ctx, "[startSelectionTurn] Policy: %s, selector: %s", m.game.Settings.GetSelectionPolicy(), selector)
+}
+
+func (m *Manager) recordCorrectAnswer(userID uuid.UUID) {
+	m.lastCorrect = &userID
+}
+
+func (m *Manager) isSelector(p *player.Player) bool {
+	if m.game.Settings.GetSelectionPolicy() == domainGame.SelectionByHost {
+		return p.Role == player.RoleHost
+	}
+	return m.game.ActivePlayer != nil && *m.game.ActivePlayer == p.UserID
+}
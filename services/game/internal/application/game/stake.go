@@ -0,0 +1,214 @@
+package game
+
+import (
+	"sort"
+
+	"github.com/google/uuid"
+	domainGame "sigame/game/internal/domain/game"
+	"sigame/game/internal/domain/pack"
+	"sigame/game/internal/domain/player"
+	"sigame/game/internal/infrastructure/logger"
+	wsMessage "sigame/game/internal/transport/ws/message"
+)
+
+func init() {
+	registerAction(wsMessage.MessageTypePlaceStake, (*Manager).handlePlaceStake)
+	registerAction(wsMessage.MessageTypePassStake, withoutPayload((*Manager).handlePassStake))
+}
+
+func (m *Manager) startStakeQuestion(question *pack.Question) {
+	logger.Infof(m.ctx, "[startStakeQuestion] Starting stake question, price: %d", question.Price)
+
+	order := m.stakeBiddingOrder()
+	if len(order) == 0 {
+		logger.Warnf(m.ctx, "[startStakeQuestion] No players can bid, skipping question")
+		m.continueGame()
+		return
+	}
+
+	minBet := question.StakeParams.GetMinStake(question.Price)
+	maxStake := question.StakeParams.GetMaxStake()
+	maxBet := minBet
+
+	limits := make(map[uuid.UUID]int, len(order))
+	for _, userID := range order {
+		limit := m.scorer.StakeLimit(m.game.Players[userID], minBet, maxStake)
+		limits[userID] = limit
+		if limit > maxBet {
+			maxBet = limit
+		}
+	}
+
+	m.stakeInfo = domainGame.NewStakeAuction(order, limits, minBet, maxBet)
+
+	logger.Infof(m.ctx, "[startStakeQuestion] Bidding order: %v, minBet: %d, maxBet: %d", order, minBet, maxBet)
+	m.advanceStakeAuction()
+}
+
+func (m *Manager) stakeBiddingOrder() []uuid.UUID {
+	contestants := make([]*player.Player, 0)
+	for _, p := range m.game.Players {
+		if p.Role.IsPlayer() && p.IsActive {
+			contestants = append(contestants, p)
+		}
+	}
+
+	sort.Slice(contestants, func(i, j int) bool {
+		if contestants[i].Score != contestants[j].Score {
+			return contestants[i].Score < contestants[j].Score
+		}
+		return contestants[i].Username < contestants[j].Username
+	})
+
+	starter := m.selectActivePlayer()
+	if m.game.ActivePlayer != nil {
+		if p, ok := m.game.Players[*m.game.ActivePlayer]; ok && p.Role.IsPlayer() && p.IsActive {
+			starter = p.UserID
+		}
+	}
+
+	start := 0
+	for i, p := range contestants {
+		if p.UserID == starter {
+			start = i
+			break
+		}
+	}
+
+	order := make([]uuid.UUID, 0, len(contestants))
+	for i := range contestants {
+		order = append(order, contestants[(start+i)%len(contestants)].UserID)
+	}
+	return order
+}
+
+func (m *Manager) advanceStakeAuction() {
+	m.timer.Stop()
+
+	turnPlayer, ok := m.stakeInfo.TurnPlayer()
+	if !ok {
+		m.finishStakeAuction()
+		return
+	}
+
+	m.game.SetActivePlayer(turnPlayer)
+	if !m.transition(domainGame.StatusStakeBetting) {
+		return
+	}
+	m.BroadcastState()
+	m.timer.Start(m.game.Settings.GetStakeBettingDuration())
+}
+
+func (m *Manager) handlePlaceStake(action *PlayerAction, payload *wsMessage.PlaceStakePayload) {
+	logger.Infof(m.ctx, "[PLACE_STAKE] Received from user: %s, game status: %s", action.UserID, m.game.Status)
+	if m.game.Status != domainGame.StatusStakeBetting || m.stakeInfo == nil {
+		logger.Warnf(m.ctx, "[PLACE_STAKE] Invalid game status: %s, expected: %s", m.game.Status, domainGame.StatusStakeBetting)
+		m.reject(action, wsMessage.ErrorCodeInvalidPhase, "stakes are not being accepted")
+		return
+	}
+
+	if err := m.placeStake(action.UserID, payload.Amount, payload.AllIn); err != nil {
+		m.rejectErr(action, err)
+	}
+}
+
+func (m *Manager) placeStake(userID uuid.UUID, amount int, allIn bool) error {
+	var err error
+	if allIn {
+		err = m.stakeInfo.AllIn(userID)
+	} else {
+		err = m.stakeInfo.PlaceBid(userID, amount)
+	}
+	if err != nil {
+		logger.Warnf(m.ctx, "[placeStake] Bid of %d (allIn=%v) from %s rejected: %v", amount, allIn, userID, err)
+		return err
+	}
+
+	bid := m.stakeBid(userID)
+	m.broadcastStakePlaced(userID, bid.Amount, bid.Status == domainGame.StakeBidAllIn, false)
+	m.advanceStakeAuction()
+	return nil
+}
+
+func (m *Manager) handlePassStake(action *PlayerAction) {
+	if m.game.Status != domainGame.StatusStakeBetting || m.stakeInfo == nil {
+		logger.Warnf(m.ctx, "[PASS_STAKE] Invalid game status: %s, expected: %s", m.game.Status, domainGame.StatusStakeBetting)
+		m.reject(action, wsMessage.ErrorCodeInvalidPhase, "stakes are not being accepted")
+		return
+	}
+
+	if err := m.passStake(action.UserID); err != nil {
+		m.rejectErr(action, err)
+	}
+}
+
+func (m *Manager) passStake(userID uuid.UUID) error {
+	if err := m.stakeInfo.Pass(userID); err != nil {
+		logger.Warnf(m.ctx, "[passStake] Pass from %s rejected: %v", userID, err)
+		return err
+	}
+
+	m.broadcastStakePlaced(userID, 0, false, true)
+	m.advanceStakeAuction()
+	return nil
+}
+
+func (m *Manager) handleStakeBettingTimeout() {
+	if m.stakeInfo == nil {
+		m.continueGame()
+		return
+	}
+
+	turnPlayer, ok := m.stakeInfo.TurnPlayer()
+	if !ok {
+		m.finishStakeAuction()
+		return
+	}
+
+	if m.stakeInfo.Leader == nil && m.stakeInfo.PlaceBid(turnPlayer, m.stakeInfo.MinBet) == nil {
+		bid := m.stakeBid(turnPlayer)
+		m.broadcastStakePlaced(turnPlayer, bid.Amount, bid.Status == domainGame.StakeBidAllIn, false)
+		m.advanceStakeAuction()
+		return
+	}
+
+	m.passStake(turnPlayer)
+}
+
+func (m *Manager) stakeBid(userID uuid.UUID) domainGame.StakeBid {
+	for _, bid := range m.stakeInfo.Bids {
+		if bid.UserID == userID {
+			return bid
+		}
+	}
+	return domainGame.StakeBid{UserID: userID}
+}
+
+func (m *Manager) broadcastStakePlaced(userID uuid.UUID, amount int, allIn, passed bool) {
+	username := ""
+	if p, ok := m.game.Players[userID]; ok {
+		username = p.Username
+	}
+
+	msg := wsMessage.NewStakePlacedMessage(userID, username, amount, allIn, passed, m.stakeInfo.Leader, m.stakeInfo.CurrentTurn)
+	m.hub.Broadcast(m.game.ID, msg)
+}
+
+func (m *Manager) finishStakeAuction() {
+	winner := m.stakeInfo.ForceWinner()
+	if winner == uuid.Nil {
+		m.continueGame()
+		return
+	}
+
+	logger.Infof(m.ctx, "[finishStakeAuction] Winner: %s, bet: %d, allIn: %v, ties: %v", winner, m.stakeInfo.CurrentBet, m.stakeInfo.IsAllIn, m.stakeInfo.AllInTies)
+	m.game.SetActivePlayer(winner)
+	m.game.CurrentQuestion.Price = m.stakeInfo.CurrentBet
+
+	if !m.transition(domainGame.StatusQuestionShow) {
+		return
+	}
+	m.BroadcastState()
+
+	m.timer.Start(m.startReading(m.game.CurrentQuestion))
+}
@@ -1,8 +1,7 @@
 package game
 
 import (
-	"encoding/json"
-	
+	"github.com/google/uuid"
 	domainGame "sigame/game/internal/domain/game"
 	"sigame/game/internal/domain/pack"
 	"sigame/game/internal/domain/player"
@@ -11,6 +10,17 @@ import (
 	wsMessage "sigame/game/internal/transport/ws/message"
 )
 
+var stateAudiences = []domainGame.Audience{
+	domainGame.AudienceHost,
+	domainGame.AudiencePlayer,
+	domainGame.AudienceSpectator,
+}
+
+type stateRecipient interface {
+	GetUserID() uuid.UUID
+	SendMessage(msg *wsMessage.ServerMessage)
+}
+
 func (m *Manager) BroadcastStateUnlocked() {
 	state := m.buildGameState()
 	m.broadcastState(state)
@@ -29,6 +39,7 @@ func (m *Manager) buildGameState() *domainGame.State {
 	state := &domainGame.State{
 		GameID:        m.game.ID,
 		Status:        m.game.Status,
+		Paused:        m.game.Paused,
 		CurrentRound:  m.game.CurrentRound,
 		Players:       make([]player.State, 0, len(m.game.Players)),
 		ActivePlayer:  m.game.ActivePlayer,
@@ -39,6 +50,10 @@ func (m *Manager) buildGameState() *domainGame.State {
 		state.Players = append(state.Players, p.ToState())
 	}
 
+	for _, spectator := range m.game.Spectators {
+		state.Spectators = append(state.Spectators, spectator.ToState())
+	}
+
 	if m.game.Status == domainGame.StatusRoundsOverview {
 		state.AllRounds = make([]domainGame.RoundOverview, 0, len(m.pack.Rounds))
 		for i, round := range m.pack.Rounds {
@@ -83,61 +98,87 @@ func (m *Manager) buildGameState() *domainGame.State {
 		state.SecretTarget = m.secretTarget
 	}
 
+	if m.secretInfo != nil {
+		state.SecretInfo = m.secretInfo
+	}
+
+	if m.answerMatch != nil {
+		state.AnswerMatch = m.answerMatch
+	}
+
+	if m.game.Status == domainGame.StatusForAllResults {
+		state.ForAllResults = m.forAllResults
+	}
+
+	if m.answerReveal != nil && m.game.Status == domainGame.StatusAnswerReveal {
+		state.AnswerReveal = m.answerReveal
+	}
+
+	switch m.game.Status {
+	case domainGame.StatusButtonPress, domainGame.StatusAnswering, domainGame.StatusAnswerJudging:
+		state.LockedOut = m.buttonPress.Excluded()
+	}
+
+	if m.game.Final != nil {
+		state.Final = m.buildFinalState()
+	}
+
 	return state
 }
 
 func (m *Manager) broadcastState(state *domainGame.State) {
-	data := m.serializeState(state)
-	if data != nil {
-		activePlayerStr := "nil"
-		if state.ActivePlayer != nil {
-			activePlayerStr = state.ActivePlayer.String()
-		}
-		themesCount := 0
-		if state.Themes != nil {
-			themesCount = len(state.Themes)
-		}
-		logger.Infof(m.ctx, "[broadcastState] Broadcasting state update: status=%s, timeRemaining=%d, activePlayer=%s, themesCount=%d", state.Status, state.TimeRemaining, activePlayerStr, themesCount)
-		m.hub.Broadcast(m.game.ID, data)
+	messages := make(map[domainGame.Audience]*wsMessage.ServerMessage, len(stateAudiences))
+	for _, audience := range stateAudiences {
+		messages[audience] = wsMessage.NewStateUpdateMessage(state.ForAudience(audience))
 	}
-}
 
-func (m *Manager) serializeState(state *domainGame.State) []byte {
-	msg := wsMessage.NewStateUpdateMessage(state)
-	data, err := msg.ToJSON()
-	if err != nil {
-		logger.Errorf(nil, "%v", ErrSerializeState(err))
-		return nil
+	activePlayerStr := "nil"
+	if state.ActivePlayer != nil {
+		activePlayerStr = state.ActivePlayer.String()
 	}
-	
-	if state.Status == domainGame.StatusAnswerJudging {
-		var jsonData map[string]interface{}
-		if err := json.Unmarshal(data, &jsonData); err == nil {
-			if payload, ok := jsonData["payload"].(map[string]interface{}); ok {
-				activePlayerVal := payload["activePlayer"]
-				logger.Infof(m.ctx, "[serializeState] answer_judging serialized: activePlayer=%v (type=%T)", activePlayerVal, activePlayerVal)
-			}
+	themesCount := 0
+	if state.Themes != nil {
+		themesCount = len(state.Themes)
+	}
+	logger.Infof(m.ctx, "[broadcastState] Broadcasting state update: status=%s, timeRemaining=%d, activePlayer=%s, themesCount=%d", state.Status, state.TimeRemaining, activePlayerStr, themesCount)
+
+	audiences := m.audiences()
+	m.hub.BroadcastPersonalized(m.game.ID, func(userID uuid.UUID) *wsMessage.ServerMessage {
+		if audience, ok := audiences[userID]; ok {
+			return messages[audience]
 		}
+		return messages[domainGame.AudienceSpectator]
+	})
+}
+
+func (m *Manager) audienceFor(userID uuid.UUID) domainGame.Audience {
+	p, ok := m.game.Players[userID]
+	if !ok {
+		return domainGame.AudienceSpectator
 	}
-	
-	return data
+	if p.Role == player.RoleHost {
+		return domainGame.AudienceHost
+	}
+	return domainGame.AudiencePlayer
+}
+
+func (m *Manager) audiences() map[uuid.UUID]domainGame.Audience {
+	audiences := make(map[uuid.UUID]domainGame.Audience, len(m.game.Players))
+	for userID := range m.game.Players {
+		audiences[userID] = m.audienceFor(userID)
+	}
+	return audiences
 }
 
 func (m *Manager) sendStateToClient(client interface{}, state *domainGame.State) {
-	clientWithSend, ok := client.(interface{ Send([]byte) })
+	clientWithSend, ok := client.(stateRecipient)
 	if !ok {
 		logger.Errorf(nil, "%v", ErrClientDoesNotImplementSend)
 		return
 	}
 
-	msg := wsMessage.NewStateUpdateMessage(state)
-	data, err := msg.ToJSON()
-	if err != nil {
-		logger.Errorf(nil, "%v", ErrSerializeStateForClient(err))
-		return
-	}
-
-	clientWithSend.Send(data)
+	audience := m.audienceFor(clientWithSend.GetUserID())
+	clientWithSend.SendMessage(wsMessage.NewStateUpdateMessage(state.ForAudience(audience)))
 }
 
 func (m *Manager) sendRoundMediaManifest(roundNumber int, manifest interface{}, totalSize int64) {
@@ -148,12 +189,6 @@ func (m *Manager) sendRoundMediaManifest(roundNumber int, manifest interface{},
 	}
 
 	msg := wsMessage.NewRoundMediaManifestMessage(roundNumber, mediaItems, totalSize)
-	data, err := msg.ToJSON()
-	if err != nil {
-		logger.Errorf(nil, "%v", ErrSerializeMediaManifest(err))
-		return
-	}
-
-	m.hub.Broadcast(m.game.ID, data)
+	m.hub.Broadcast(m.game.ID, msg)
 }
 
@@ -1,8 +1,10 @@
 package game
 
 import (
+	"sort"
 	"time"
 
+	"sigame/game/internal/core/answer"
 	domainGame "sigame/game/internal/domain/game"
 	"sigame/game/internal/infrastructure/logger"
 )
@@ -11,11 +13,20 @@ func (m *Manager) handleTimeout() {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
+	if m.game.Paused {
+		logger.Infof(m.ctx, "[handleTimeout] Game is paused, deferring timeout for status: %s", m.game.Status)
+		return
+	}
+
 	switch m.game.Status {
 	case domainGame.StatusRoundsOverview:
 		m.startRound(FirstRoundNumber)
 
 	case domainGame.StatusRoundStart:
+		if round := m.pack.GetRound(m.game.CurrentRound); round != nil && round.IsFinal() {
+			m.beginFinalRound()
+			return
+		}
 		m.transitionToQuestionSelect()
 
 	case domainGame.StatusQuestionSelect:
@@ -43,6 +54,9 @@ func (m *Manager) handleTimeout() {
 	case domainGame.StatusSecretTransfer:
 		m.handleSecretTransferTimeout()
 
+	case domainGame.StatusSecretPriceSelect:
+		m.handleSecretPriceTimeout()
+
 	case domainGame.StatusStakeBetting:
 		m.handleStakeBettingTimeout()
 
@@ -51,6 +65,21 @@ func (m *Manager) handleTimeout() {
 
 	case domainGame.StatusForAllResults:
 		m.continueGame()
+
+	case domainGame.StatusAnswerReveal:
+		m.finishAnswerReveal()
+
+	case domainGame.StatusFinalThemeSelect:
+		m.handleFinalThemeSelectTimeout()
+
+	case domainGame.StatusFinalStake:
+		m.handleFinalStakeTimeout()
+
+	case domainGame.StatusFinalAnswering:
+		m.startFinalJudging()
+
+	case domainGame.StatusFinalJudging:
+		m.handleFinalJudgingTimeout()
 	}
 }
 
@@ -58,14 +87,24 @@ func (m *Manager) handleTimerTick() {
 	m.mu.RLock()
 	defer m.mu.RUnlock()
 
+	if m.game.Paused {
+		return
+	}
+
 	switch m.game.Status {
 	case domainGame.StatusQuestionSelect,
 		domainGame.StatusButtonPress,
 		domainGame.StatusAnswering,
 		domainGame.StatusAnswerJudging,
 		domainGame.StatusSecretTransfer,
+		domainGame.StatusSecretPriceSelect,
 		domainGame.StatusStakeBetting,
-		domainGame.StatusForAllAnswering:
+		domainGame.StatusForAllAnswering,
+		domainGame.StatusAnswerReveal,
+		domainGame.StatusFinalThemeSelect,
+		domainGame.StatusFinalStake,
+		domainGame.StatusFinalAnswering,
+		domainGame.StatusFinalJudging:
 		m.BroadcastStateUnlocked()
 	}
 }
@@ -96,7 +135,9 @@ func (m *Manager) skipQuestion() {
 		winner := m.buttonPress.GetWinner()
 		if winner != nil {
 			m.game.SetActivePlayer(winner.UserID)
-			m.game.UpdateStatus(domainGame.StatusAnswering)
+			if !m.transition(domainGame.StatusAnswering) {
+				return
+			}
 			m.BroadcastState()
 			m.timer.Start(time.Duration(m.game.Settings.TimeForAnswer) * time.Second)
 			return
@@ -116,53 +157,42 @@ func (m *Manager) handleAnswerTimeout() {
 		return
 	}
 
-	p := m.game.Players[*m.game.ActivePlayer]
-	questionPrice := m.game.CurrentQuestion.Price
-	p.SubtractScore(questionPrice)
+	userID := *m.game.ActivePlayer
+	p := m.game.Players[userID]
+	delta := m.scoreTimeout(p)
+	m.broadcastAnswerResult(p, false, delta)
 
-	m.continueGame()
-}
-
-func (m *Manager) handleSecretTransferTimeout() {
-	hostID := m.findHost()
-
-	for userID, p := range m.game.Players {
-		if !p.Role.IsHost() {
-			m.transferSecretToPlayer(hostID, userID)
-			return
-		}
-	}
-
-	m.continueGame()
-}
-
-func (m *Manager) handleStakeBettingTimeout() {
-	if m.game.ActivePlayer == nil || m.stakeInfo == nil {
-		m.continueGame()
-		return
-	}
-
-	m.placeStake(*m.game.ActivePlayer, m.stakeInfo.MinBet, false)
+	m.reopenButtonsOrContinue(userID)
 }
 
 func (m *Manager) finishForAllQuestion() {
 	m.forAllCollector.Close()
 
-	results := m.forAllCollector.GetResults(func(userAnswer, correctAnswer string) bool {
-		return m.game.CurrentQuestion.ValidateAnswer(userAnswer)
+	results := m.forAllCollector.GetMatchedResults(func(userAnswer, correctAnswer string) answer.MatchResult {
+		return m.matchAnswer(userAnswer)
 	})
 
+	value := m.questionValue()
+	m.forAllResults = make([]domainGame.ForAllResult, 0, len(results))
 	for userID, result := range results {
-		p := m.game.Players[userID]
-		if result.IsCorrect {
-			p.AddScore(result.ScoreDelta)
-		} else {
-			p.SubtractScore(result.ScoreDelta)
-		}
+		delta := m.scorer.ForAll(m.game.Players[userID], result.IsCorrect, value)
+		m.forAllResults = append(m.forAllResults, domainGame.ForAllResult{
+			UserID:     result.UserID,
+			Username:   result.Username,
+			Answer:     result.Answer,
+			IsCorrect:  result.IsCorrect,
+			ScoreDelta: delta,
+			Confidence: result.Confidence,
+		})
 	}
+	sort.Slice(m.forAllResults, func(i, j int) bool {
+		return m.forAllResults[i].Username < m.forAllResults[j].Username
+	})
 
-	m.game.UpdateStatus(domainGame.StatusForAllResults)
+	if !m.transition(domainGame.StatusForAllResults) {
+		return
+	}
 	m.BroadcastState()
-	m.timer.Start(ForAllResultsDisplayDuration)
+	m.timer.Start(m.game.Settings.GetResultsDisplayDuration())
 }
 
@@ -0,0 +1,7 @@
+package answer
+
+const (
+	FullConfidence       = 1.0
+	NoConfidence         = 0.0
+	BorderlineConfidence = 0.5
+)
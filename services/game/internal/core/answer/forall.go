@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"sigame/game/internal/core/clock"
 )
 
 type ForAllAnswer struct {
@@ -20,12 +21,14 @@ type ForAllCollector struct {
 	questionPrice int
 	startedAt     time.Time
 	closed        bool
+	clock         clock.Clock
 	mu            sync.Mutex
 }
 
-func NewForAllCollector() *ForAllCollector {
+func NewForAllCollector(clk clock.Clock) *ForAllCollector {
 	return &ForAllCollector{
 		answers: make(map[uuid.UUID]*ForAllAnswer),
+		clock:   clk,
 	}
 }
 
@@ -36,7 +39,7 @@ func (c *ForAllCollector) Start(correctAnswer string, questionPrice int) {
 	c.answers = make(map[uuid.UUID]*ForAllAnswer)
 	c.correctAnswer = correctAnswer
 	c.questionPrice = questionPrice
-	c.startedAt = time.Now()
+	c.startedAt = c.clock.Now()
 	c.closed = false
 }
 
@@ -56,7 +59,7 @@ func (c *ForAllCollector) SubmitAnswer(userID uuid.UUID, username, answer string
 		UserID:      userID,
 		Username:    username,
 		Answer:      answer,
-		SubmittedAt: time.Now(),
+		SubmittedAt: c.clock.Now(),
 	}
 
 	return true
@@ -88,13 +91,23 @@ func (c *ForAllCollector) IsClosed() bool {
 }
 
 func (c *ForAllCollector) GetResults(validateAnswer func(userAnswer, correctAnswer string) bool) map[uuid.UUID]ForAllResult {
+	return c.GetMatchedResults(func(userAnswer, correctAnswer string) MatchResult {
+		if validateAnswer(userAnswer, correctAnswer) {
+			return MatchResult{Matched: true, Confidence: FullConfidence, MatchedAnswer: correctAnswer}
+		}
+		return MatchResult{Confidence: NoConfidence}
+	})
+}
+
+func (c *ForAllCollector) GetMatchedResults(match func(userAnswer, correctAnswer string) MatchResult) map[uuid.UUID]ForAllResult {
 	c.mu.Lock()
 	defer c.mu.Unlock()
 
 	results := make(map[uuid.UUID]ForAllResult)
 
 	for userID, answer := range c.answers {
-		isCorrect := validateAnswer(answer.Answer, c.correctAnswer)
+		matchResult := match(answer.Answer, c.correctAnswer)
+		isCorrect := matchResult.Matched
 		scoreDelta := 0
 		if isCorrect {
 			scoreDelta = c.questionPrice
@@ -108,6 +121,7 @@ func (c *ForAllCollector) GetResults(validateAnswer func(userAnswer, correctAnsw
 			Answer:     answer.Answer,
 			IsCorrect:  isCorrect,
 			ScoreDelta: scoreDelta,
+			Confidence: matchResult.Confidence,
 		}
 	}
 
@@ -153,5 +167,6 @@ type ForAllResult struct {
 	Answer     string
 	IsCorrect  bool
 	ScoreDelta int
+	Confidence float64
 }
 
@@ -6,24 +6,25 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"sigame/game/internal/core/clock"
 )
 
 func TestNewForAllCollector(t *testing.T) {
-	collector := NewForAllCollector()
+	collector := NewForAllCollector(clock.Real())
 
 	if collector == nil {
-		t.Fatal("NewForAllCollector() returned nil")
+		t.Fatal("NewForAllCollector(clock.Real()) returned nil")
 	}
 	if collector.answers == nil {
-		t.Error("NewForAllCollector() answers is nil")
+		t.Error("NewForAllCollector(clock.Real()) answers is nil")
 	}
 	if len(collector.answers) != 0 {
-		t.Errorf("NewForAllCollector() answers length = %d, want 0", len(collector.answers))
+		t.Errorf("NewForAllCollector(clock.Real()) answers length = %d, want 0", len(collector.answers))
 	}
 }
 
 func TestForAllCollector_Start(t *testing.T) {
-	collector := NewForAllCollector()
+	collector := NewForAllCollector(clock.Real())
 	correctAnswer := "Correct Answer"
 	questionPrice := 100
 
@@ -47,7 +48,7 @@ func TestForAllCollector_Start(t *testing.T) {
 }
 
 func TestForAllCollector_SubmitAnswer(t *testing.T) {
-	collector := NewForAllCollector()
+	collector := NewForAllCollector(clock.Real())
 	collector.Start("Answer", 100)
 
 	userID := uuid.New()
@@ -79,7 +80,7 @@ func TestForAllCollector_SubmitAnswer(t *testing.T) {
 }
 
 func TestForAllCollector_SubmitAnswer_Duplicate(t *testing.T) {
-	collector := NewForAllCollector()
+	collector := NewForAllCollector(clock.Real())
 	collector.Start("Answer", 100)
 
 	userID := uuid.New()
@@ -101,7 +102,7 @@ func TestForAllCollector_SubmitAnswer_Duplicate(t *testing.T) {
 }
 
 func TestForAllCollector_SubmitAnswer_Closed(t *testing.T) {
-	collector := NewForAllCollector()
+	collector := NewForAllCollector(clock.Real())
 	collector.Start("Answer", 100)
 	collector.Close()
 
@@ -117,7 +118,7 @@ func TestForAllCollector_SubmitAnswer_Closed(t *testing.T) {
 }
 
 func TestForAllCollector_HasAnswered(t *testing.T) {
-	collector := NewForAllCollector()
+	collector := NewForAllCollector(clock.Real())
 	collector.Start("Answer", 100)
 
 	userID := uuid.New()
@@ -134,7 +135,7 @@ func TestForAllCollector_HasAnswered(t *testing.T) {
 }
 
 func TestForAllCollector_GetAnswerCount(t *testing.T) {
-	collector := NewForAllCollector()
+	collector := NewForAllCollector(clock.Real())
 	collector.Start("Answer", 100)
 
 	if collector.GetAnswerCount() != 0 {
@@ -150,7 +151,7 @@ func TestForAllCollector_GetAnswerCount(t *testing.T) {
 }
 
 func TestForAllCollector_Close(t *testing.T) {
-	collector := NewForAllCollector()
+	collector := NewForAllCollector(clock.Real())
 	collector.Start("Answer", 100)
 
 	collector.Close()
@@ -161,7 +162,7 @@ func TestForAllCollector_Close(t *testing.T) {
 }
 
 func TestForAllCollector_IsClosed(t *testing.T) {
-	collector := NewForAllCollector()
+	collector := NewForAllCollector(clock.Real())
 	collector.Start("Answer", 100)
 
 	if collector.IsClosed() {
@@ -176,7 +177,7 @@ func TestForAllCollector_IsClosed(t *testing.T) {
 }
 
 func TestForAllCollector_GetResults(t *testing.T) {
-	collector := NewForAllCollector()
+	collector := NewForAllCollector(clock.Real())
 	collector.Start("Correct", 100)
 
 	user1 := uuid.New()
@@ -220,7 +221,7 @@ func TestForAllCollector_GetResults(t *testing.T) {
 }
 
 func TestForAllCollector_GetAllAnswers(t *testing.T) {
-	collector := NewForAllCollector()
+	collector := NewForAllCollector(clock.Real())
 	collector.Start("Answer", 100)
 
 	if collector.GetAllAnswers() != nil && len(collector.GetAllAnswers()) != 0 {
@@ -250,7 +251,7 @@ func TestForAllCollector_GetAllAnswers(t *testing.T) {
 }
 
 func TestForAllCollector_GetCorrectAnswer(t *testing.T) {
-	collector := NewForAllCollector()
+	collector := NewForAllCollector(clock.Real())
 	correctAnswer := "Correct Answer"
 
 	collector.Start(correctAnswer, 100)
@@ -261,7 +262,7 @@ func TestForAllCollector_GetCorrectAnswer(t *testing.T) {
 }
 
 func TestForAllCollector_GetQuestionPrice(t *testing.T) {
-	collector := NewForAllCollector()
+	collector := NewForAllCollector(clock.Real())
 	questionPrice := 200
 
 	collector.Start("Answer", questionPrice)
@@ -272,7 +273,7 @@ func TestForAllCollector_GetQuestionPrice(t *testing.T) {
 }
 
 func TestForAllCollector_Reset(t *testing.T) {
-	collector := NewForAllCollector()
+	collector := NewForAllCollector(clock.Real())
 	collector.Start("Answer", 100)
 
 	userID := uuid.New()
@@ -296,7 +297,7 @@ func TestForAllCollector_Reset(t *testing.T) {
 }
 
 func TestForAllCollector_ConcurrentAccess(t *testing.T) {
-	collector := NewForAllCollector()
+	collector := NewForAllCollector(clock.Real())
 	collector.Start("Answer", 100)
 
 	done := make(chan bool)
@@ -322,7 +323,7 @@ func TestForAllCollector_ConcurrentAccess(t *testing.T) {
 }
 
 func TestForAllAnswer_SubmittedAt(t *testing.T) {
-	collector := NewForAllCollector()
+	collector := NewForAllCollector(clock.Real())
 	collector.Start("Answer", 100)
 
 	userID := uuid.New()
@@ -343,3 +344,41 @@ func TestForAllAnswer_SubmittedAt(t *testing.T) {
 	}
 }
 
+func TestForAllCollector_GetMatchedResults(t *testing.T) {
+	collector := NewForAllCollector(clock.Real())
+	collector.Start("Correct", 100)
+
+	user := uuid.New()
+	collector.SubmitAnswer(user, "user", "Corect")
+
+	results := collector.GetMatchedResults(func(userAnswer, correctAnswer string) MatchResult {
+		return MatchResult{Matched: true, Confidence: 0.85, MatchedAnswer: correctAnswer}
+	})
+
+	if !results[user].IsCorrect {
+		t.Error("GetMatchedResults() IsCorrect = false, want true")
+	}
+	if results[user].Confidence != 0.85 {
+		t.Errorf("GetMatchedResults() Confidence = %v, want 0.85", results[user].Confidence)
+	}
+}
+
+func TestForAllAnswer_SubmittedAt_FakeClock(t *testing.T) {
+	start := time.Unix(0, 0)
+	fake := clock.NewFake(start)
+	collector := NewForAllCollector(fake)
+	collector.Start("Answer", 100)
+
+	fake.Advance(3 * time.Second)
+
+	userID := uuid.New()
+	collector.SubmitAnswer(userID, "user1", "Answer")
+
+	answers := collector.GetAllAnswers()
+	if len(answers) != 1 {
+		t.Fatalf("Expected 1 answer, got %d", len(answers))
+	}
+	if want := start.Add(3 * time.Second); !answers[0].SubmittedAt.Equal(want) {
+		t.Errorf("SubmittedAt = %v, want %v", answers[0].SubmittedAt, want)
+	}
+}
@@ -0,0 +1,249 @@
+package answer
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+
+	domainGame "sigame/game/internal/domain/game"
+)
+
+type MatchResult struct {
+	Matched       bool
+	Confidence    float64
+	MatchedAnswer string
+}
+
+func (r MatchResult) IsBorderline() bool {
+	return r.Confidence >= BorderlineConfidence && r.Confidence < FullConfidence
+}
+
+type AnswerMatcher interface {
+	Match(userAnswer string, acceptedAnswers []string) MatchResult
+}
+
+func NewMatcher(strategy domainGame.AnswerMatchStrategy, threshold float64) AnswerMatcher {
+	switch strategy {
+	case domainGame.AnswerMatchExact:
+		return ExactMatcher{}
+	case domainGame.AnswerMatchEditDistance:
+		return EditDistanceMatcher{Threshold: threshold}
+	case domainGame.AnswerMatchNumeric:
+		return NumericMatcher{}
+	default:
+		return NormalizedMatcher{}
+	}
+}
+
+type ExactMatcher struct{}
+
+func (ExactMatcher) Match(userAnswer string, acceptedAnswers []string) MatchResult {
+	given := strings.TrimSpace(strings.ToLower(userAnswer))
+	for _, accepted := range acceptedAnswers {
+		if given == strings.TrimSpace(strings.ToLower(accepted)) {
+			return MatchResult{Matched: true, Confidence: FullConfidence, MatchedAnswer: accepted}
+		}
+	}
+	return MatchResult{Confidence: NoConfidence}
+}
+
+type NormalizedMatcher struct{}
+
+func (NormalizedMatcher) Match(userAnswer string, acceptedAnswers []string) MatchResult {
+	given := Normalize(userAnswer)
+	for _, accepted := range acceptedAnswers {
+		if given != "" && given == Normalize(accepted) {
+			return MatchResult{Matched: true, Confidence: FullConfidence, MatchedAnswer: accepted}
+		}
+	}
+	return MatchResult{Confidence: NoConfidence}
+}
+
+type EditDistanceMatcher struct {
+	Threshold float64
+}
+
+func (m EditDistanceMatcher) Match(userAnswer string, acceptedAnswers []string) MatchResult {
+	given := Normalize(userAnswer)
+	best := MatchResult{Confidence: NoConfidence}
+	if given == "" {
+		return best
+	}
+
+	for _, accepted := range acceptedAnswers {
+		confidence := Similarity(given, Normalize(accepted))
+		if confidence > best.Confidence {
+			best = MatchResult{Confidence: confidence, MatchedAnswer: accepted}
+		}
+	}
+
+	best.Matched = best.Confidence >= m.Threshold
+	if !best.Matched {
+		best.MatchedAnswer = ""
+	}
+	return best
+}
+
+type NumericMatcher struct{}
+
+func (NumericMatcher) Match(userAnswer string, acceptedAnswers []string) MatchResult {
+	given, ok := ParseNumber(userAnswer)
+	if !ok {
+		return NormalizedMatcher{}.Match(userAnswer, acceptedAnswers)
+	}
+
+	for _, accepted := range acceptedAnswers {
+		expected, ok := ParseNumber(accepted)
+		if ok && math.Abs(expected-given) < 1e-9 {
+			return MatchResult{Matched: true, Confidence: FullConfidence, MatchedAnswer: accepted}
+		}
+	}
+	return NormalizedMatcher{}.Match(userAnswer, acceptedAnswers)
+}
+
+var stopwords = map[string]bool{
+	"the": true, "a": true, "an": true, "of": true, "and": true,
+	"и": true, "в": true, "во": true, "на": true, "по": true, "из": true,
+}
+
+func Normalize(s string) string {
+	s = strings.ToLower(s)
+	s = strings.ReplaceAll(s, "ё", "е")
+
+	tokens := strings.Fields(s)
+	kept := make([]string, 0, len(tokens))
+	for _, token := range tokens {
+		if !initials.MatchString(token) {
+			kept = append(kept, token)
+		}
+	}
+	if len(kept) > 0 {
+		s = strings.Join(kept, " ")
+	}
+
+	fields := strings.FieldsFunc(s, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	words := make([]string, 0, len(fields))
+	for _, field := range fields {
+		if stopwords[field] {
+			continue
+		}
+		words = append(words, field)
+	}
+
+	if len(words) == 0 {
+		words = fields
+	}
+	return strings.Join(words, " ")
+}
+
+func Similarity(a, b string) float64 {
+	if a == b {
+		return FullConfidence
+	}
+
+	ra, rb := []rune(a), []rune(b)
+	longest := len(ra)
+	if len(rb) > longest {
+		longest = len(rb)
+	}
+	if longest == 0 {
+		return NoConfidence
+	}
+
+	return 1 - float64(levenshtein(ra, rb))/float64(longest)
+}
+
+func levenshtein(a, b []rune) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+
+	return prev[len(b)]
+}
+
+var numberWords = map[string]float64{
+	"zero": 0, "one": 1, "two": 2, "three": 3, "four": 4, "five": 5, "six": 6, "seven": 7, "eight": 8, "nine": 9,
+	"ten": 10, "eleven": 11, "twelve": 12, "thirteen": 13, "fourteen": 14, "fifteen": 15, "sixteen": 16,
+	"seventeen": 17, "eighteen": 18, "nineteen": 19, "twenty": 20, "thirty": 30, "forty": 40, "fifty": 50,
+	"sixty": 60, "seventy": 70, "eighty": 80, "ninety": 90,
+	"ноль": 0, "один": 1, "одна": 1, "два": 2, "две": 2, "три": 3, "четыре": 4, "пять": 5, "шесть": 6, "семь": 7,
+	"восемь": 8, "девять": 9, "десять": 10, "одиннадцать": 11, "двенадцать": 12, "тринадцать": 13,
+	"четырнадцать": 14, "пятнадцать": 15, "шестнадцать": 16, "семнадцать": 17, "восемнадцать": 18,
+	"девятнадцать": 19, "двадцать": 20, "тридцать": 30, "сорок": 40, "пятьдесят": 50, "шестьдесят": 60,
+	"семьдесят": 70, "восемьдесят": 80, "девяносто": 90, "сто": 100, "двести": 200, "триста": 300,
+	"четыреста": 400, "пятьсот": 500, "шестьсот": 600, "семьсот": 700, "восемьсот": 800, "девятьсот": 900,
+}
+
+var numberMultipliers = map[string]float64{
+	"hundred": 100, "thousand": 1000, "million": 1000000,
+	"тысяча": 1000, "тысячи": 1000, "тысяч": 1000, "миллион": 1000000, "миллиона": 1000000, "миллионов": 1000000,
+}
+
+var initials = regexp.MustCompile(`^(\pL\.)+$`)
+
+var thousandsGrouped = regexp.MustCompile(`^-?[1-9]\d{0,2}(,\d{3})+(\.\d+)?$`)
+
+func normalizeNumber(s string) string {
+	trimmed := strings.ReplaceAll(strings.TrimSpace(s), " ", "")
+
+	if thousandsGrouped.MatchString(trimmed) {
+		return strings.ReplaceAll(trimmed, ",", "")
+	}
+	if strings.Count(trimmed, ",") == 1 && !strings.Contains(trimmed, ".") {
+		return strings.Replace(trimmed, ",", ".", 1)
+	}
+	return trimmed
+}
+
+func ParseNumber(s string) (float64, bool) {
+	if value, err := strconv.ParseFloat(normalizeNumber(s), 64); err == nil {
+		return value, true
+	}
+
+	words := strings.Fields(Normalize(strings.ReplaceAll(s, "-", " ")))
+	if len(words) == 0 {
+		return 0, false
+	}
+
+	total, current := 0.0, 0.0
+	for _, word := range words {
+		if value, ok := numberWords[word]; ok {
+			current += value
+			continue
+		}
+		multiplier, ok := numberMultipliers[word]
+		if !ok {
+			return 0, false
+		}
+		if current == 0 {
+			current = 1
+		}
+		if multiplier == 100 {
+			current *= multiplier
+			continue
+		}
+		total += current * multiplier
+		current = 0
+	}
+
+	return total + current, true
+}
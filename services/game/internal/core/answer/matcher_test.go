@@ -0,0 +1,148 @@
+package answer
+
+import (
+	"testing"
+
+	domainGame "sigame/game/internal/domain/game"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		input string
+		want  string
+	}{
+		{"  Пушкин А.С. ", "пушкин"},
+		{"Пушкин А. С.", "пушкин"},
+		{"Vitamin C", "vitamin c"},
+		{"Henry V", "henry v"},
+		{"Ёлка", "елка"},
+		{"The Beatles!", "beatles"},
+		{"Война и мир", "война мир"},
+		{"A", "a"},
+	}
+
+	for _, tt := range tests {
+		if got := Normalize(tt.input); got != tt.want {
+			t.Errorf("Normalize(%q) = %q, want %q", tt.input, got, tt.want)
+		}
+	}
+}
+
+func TestExactMatcher(t *testing.T) {
+	matcher := NewMatcher(domainGame.AnswerMatchExact, 0)
+
+	if result := matcher.Match(" pushkin ", []string{"Pushkin"}); !result.Matched || result.Confidence != FullConfidence {
+		t.Errorf("Match() = %+v, want full match", result)
+	}
+	if result := matcher.Match("Пушкин А.С.", []string{"Пушкин"}); result.Matched {
+		t.Error("Match() exact matched initials, want no match")
+	}
+}
+
+func TestNormalizedMatcher(t *testing.T) {
+	matcher := NewMatcher(domainGame.AnswerMatchNormalized, 0)
+
+	tests := []struct {
+		given    string
+		accepted []string
+		want     bool
+	}{
+		{"Пушкин А.С.", []string{"Пушкин"}, true},
+		{"ежик", []string{"Ёжик"}, true},
+		{"beatles", []string{"The Beatles"}, true},
+		{"Lermontov", []string{"Pushkin", "Lermontov"}, true},
+		{"Gogol", []string{"Pushkin"}, false},
+		{"Vitamin C", []string{"Vitamin D"}, false},
+		{"Plan A", []string{"Plan B"}, false},
+		{"Henry V", []string{"Henry I"}, false},
+		{"", []string{""}, false},
+	}
+
+	for _, tt := range tests {
+		result := matcher.Match(tt.given, tt.accepted)
+		if result.Matched != tt.want {
+			t.Errorf("Match(%q, %v) matched = %v, want %v", tt.given, tt.accepted, result.Matched, tt.want)
+		}
+	}
+}
+
+func TestEditDistanceMatcher(t *testing.T) {
+	matcher := NewMatcher(domainGame.AnswerMatchEditDistance, 0.8)
+
+	result := matcher.Match("Достоевскйи", []string{"Достоевский"})
+	if !result.Matched {
+		t.Errorf("Match() typo = %+v, want match", result)
+	}
+	if !result.IsBorderline() {
+		t.Errorf("Match() typo confidence = %v, want borderline", result.Confidence)
+	}
+
+	result = matcher.Match("Толстой", []string{"Достоевский"})
+	if result.Matched {
+		t.Errorf("Match() different word = %+v, want no match", result)
+	}
+
+	result = matcher.Match("достоевский", []string{"Достоевский"})
+	if result.Confidence != FullConfidence {
+		t.Errorf("Match() identical confidence = %v, want %v", result.Confidence, FullConfidence)
+	}
+}
+
+func TestNumericMatcher(t *testing.T) {
+	matcher := NewMatcher(domainGame.AnswerMatchNumeric, 0)
+
+	tests := []struct {
+		given    string
+		accepted []string
+		want     bool
+	}{
+		{"7", []string{"seven"}, true},
+		{"семь", []string{"7"}, true},
+		{"twenty-one", []string{"21"}, true},
+		{"две тысячи пять", []string{"2005"}, true},
+		{"3,5", []string{"3.5"}, true},
+		{"8", []string{"seven"}, false},
+		{"Пушкин", []string{"пушкин"}, true},
+	}
+
+	for _, tt := range tests {
+		result := matcher.Match(tt.given, tt.accepted)
+		if result.Matched != tt.want {
+			t.Errorf("Match(%q, %v) matched = %v, want %v", tt.given, tt.accepted, result.Matched, tt.want)
+		}
+	}
+}
+
+func TestParseNumber(t *testing.T) {
+	tests := []struct {
+		given  string
+		want   float64
+		wantOK bool
+	}{
+		{"3,5", 3.5, true},
+		{"1,000", 1000, true},
+		{"1,000,000", 1000000, true},
+		{"1,000.25", 1000.25, true},
+		{"1 000 000", 1000000, true},
+		{"0,125", 0.125, true},
+		{"12,50", 12.5, true},
+		{"три", 3, true},
+		{"пушкин", 0, false},
+	}
+
+	for _, tt := range tests {
+		got, ok := ParseNumber(tt.given)
+		if ok != tt.wantOK || got != tt.want {
+			t.Errorf("ParseNumber(%q) = %v, %v, want %v, %v", tt.given, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
+
+func TestSimilarity(t *testing.T) {
+	if got := Similarity("kitten", "sitting"); got < 0.57 || got > 0.58 {
+		t.Errorf("Similarity() = %v, want ~0.571", got)
+	}
+	if got := Similarity("", ""); got != FullConfidence {
+		t.Errorf("Similarity() empty = %v, want %v", got, FullConfidence)
+	}
+}
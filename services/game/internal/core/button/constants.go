@@ -1,4 +1,9 @@
 package button
 
-const RTTCompensationFactor = 2
+import "time"
 
+const (
+	RTTCompensationFactor = 2
+	MaxCompensation       = 500 * time.Millisecond
+	ClockSkewTolerance    = 50 * time.Millisecond
+)
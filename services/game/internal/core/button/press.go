@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"sigame/game/internal/core/clock"
 )
 
 type PressEntry struct {
@@ -14,21 +15,36 @@ type PressEntry struct {
 	ReceivedAt   time.Time
 	AdjustedTime time.Time
 	RTT          time.Duration
+	ClientTime   time.Time
+	Suspicious   bool
+	FalseStart   bool
+	LockedUntil  time.Time
+}
+
+type ClientTiming struct {
+	PressedAt time.Time
+	Offset    time.Duration
 }
 
 type Press struct {
 	entries      []PressEntry
 	pressedUsers map[uuid.UUID]bool
+	excluded     map[uuid.UUID]bool
+	falseStarts  map[uuid.UUID]PressEntry
 	questionAt   time.Time
 	closed       bool
+	clock        clock.Clock
 	mu           sync.Mutex
 }
 
-func New() *Press {
+func New(clk clock.Clock) *Press {
 	return &Press{
 		entries:      make([]PressEntry, 0),
 		pressedUsers: make(map[uuid.UUID]bool),
+		excluded:     make(map[uuid.UUID]bool),
+		falseStarts:  make(map[uuid.UUID]PressEntry),
 		closed:       false,
+		clock:        clk,
 	}
 }
 
@@ -38,38 +54,203 @@ func (b *Press) Reset() {
 
 	b.entries = make([]PressEntry, 0)
 	b.pressedUsers = make(map[uuid.UUID]bool)
-	b.questionAt = time.Now()
+	b.excluded = make(map[uuid.UUID]bool)
+	b.falseStarts = make(map[uuid.UUID]PressEntry)
+	b.questionAt = b.clock.Now()
+	b.closed = false
+}
+
+func (b *Press) Open(lockout time.Duration) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	now := b.clock.Now()
+	b.entries = make([]PressEntry, 0)
+	b.pressedUsers = make(map[uuid.UUID]bool)
+	b.excluded = make(map[uuid.UUID]bool)
+	b.questionAt = now
+	b.closed = false
+
+	for userID, entry := range b.falseStarts {
+		entry.LockedUntil = now.Add(lockout)
+		b.falseStarts[userID] = entry
+	}
+}
+
+func (b *Press) RecordFalseStart(userID uuid.UUID, username string) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if _, exists := b.falseStarts[userID]; exists {
+		return false
+	}
+
+	b.falseStarts[userID] = PressEntry{
+		UserID:     userID,
+		Username:   username,
+		ReceivedAt: b.clock.Now(),
+		FalseStart: true,
+	}
+	return true
+}
+
+func (b *Press) FalseStarts() []PressEntry {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if len(b.falseStarts) == 0 {
+		return nil
+	}
+
+	result := make([]PressEntry, 0, len(b.falseStarts))
+	for _, entry := range b.falseStarts {
+		result = append(result, entry)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ReceivedAt.Before(result[j].ReceivedAt)
+	})
+
+	return result
+}
+
+func (b *Press) IsLockedOut(userID uuid.UUID) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.isLockedOut(userID, b.clock.Now())
+}
+
+func (b *Press) isLockedOut(userID uuid.UUID, now time.Time) bool {
+	entry, exists := b.falseStarts[userID]
+	return exists && now.Before(entry.LockedUntil)
+}
+
+func (b *Press) Reopen() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.entries = make([]PressEntry, 0)
+	b.pressedUsers = make(map[uuid.UUID]bool)
+	b.questionAt = b.clock.Now()
 	b.closed = false
 }
 
+func (b *Press) Exclude(userID uuid.UUID) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.excluded[userID] = true
+}
+
+func (b *Press) Withdraw(userID uuid.UUID) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.excluded[userID] = true
+
+	entries := b.entries[:0]
+	for _, entry := range b.entries {
+		if entry.UserID != userID {
+			entries = append(entries, entry)
+		}
+	}
+	b.entries = entries
+}
+
+func (b *Press) IsExcluded(userID uuid.UUID) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.excluded[userID]
+}
+
+func (b *Press) Excluded() []uuid.UUID {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if len(b.excluded) == 0 {
+		return nil
+	}
+
+	result := make([]uuid.UUID, 0, len(b.excluded))
+	for userID := range b.excluded {
+		result = append(result, userID)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].String() < result[j].String()
+	})
+
+	return result
+}
+
 func (b *Press) Press(userID uuid.UUID, username string, rtt time.Duration) bool {
+	_, ok := b.PressWithClientTime(userID, username, rtt, nil)
+	return ok
+}
+
+func (b *Press) PressWithClientTime(userID uuid.UUID, username string, rtt time.Duration, timing *ClientTiming) (PressEntry, bool) {
 	b.mu.Lock()
 	defer b.mu.Unlock()
 
 	if b.closed {
-		return false
+		return PressEntry{}, false
 	}
 
-	if b.pressedUsers[userID] {
-		return false
+	if b.pressedUsers[userID] || b.excluded[userID] {
+		return PressEntry{}, false
 	}
 
-	now := time.Now()
-	oneWayDelay := rtt / RTTCompensationFactor
-	adjustedTime := now.Add(-oneWayDelay)
+	now := b.clock.Now()
+	if b.isLockedOut(userID, now) {
+		return PressEntry{}, false
+	}
 
 	entry := PressEntry{
-		UserID:       userID,
-		Username:     username,
-		ReceivedAt:   now,
-		AdjustedTime: adjustedTime,
-		RTT:          rtt,
+		UserID:     userID,
+		Username:   username,
+		ReceivedAt: now,
+		RTT:        rtt,
+	}
+	if timing != nil {
+		entry.ClientTime = timing.PressedAt
 	}
+	entry.AdjustedTime, entry.Suspicious = b.adjustedTime(now, rtt, timing)
 
 	b.entries = append(b.entries, entry)
 	b.pressedUsers[userID] = true
 
-	return true
+	return entry, true
+}
+
+func (b *Press) adjustedTime(now time.Time, rtt time.Duration, timing *ClientTiming) (time.Time, bool) {
+	oneWayDelay := rtt / RTTCompensationFactor
+	if oneWayDelay > MaxCompensation {
+		oneWayDelay = MaxCompensation
+	}
+	estimate := now.Add(-oneWayDelay)
+	if timing == nil {
+		return estimate, false
+	}
+
+	maxDelay := rtt + ClockSkewTolerance
+	if maxDelay > MaxCompensation {
+		maxDelay = MaxCompensation
+	}
+	earliest := now.Add(-maxDelay)
+
+	pressedAt := timing.PressedAt.Add(-timing.Offset)
+	suspicious := false
+
+	switch {
+	case pressedAt.Before(earliest), pressedAt.After(now.Add(ClockSkewTolerance)):
+		pressedAt, suspicious = estimate, true
+	case pressedAt.After(now):
+		pressedAt = now
+	}
+
+	if pressedAt.Before(b.questionAt) {
+		return b.questionAt, suspicious || pressedAt.Before(b.questionAt.Add(-ClockSkewTolerance))
+	}
+	return pressedAt, suspicious
 }
 
 func (b *Press) Close() {
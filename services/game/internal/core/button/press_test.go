@@ -5,27 +5,28 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"sigame/game/internal/core/clock"
 )
 
 func TestNew(t *testing.T) {
-	bp := New()
+	bp := New(clock.Real())
 
 	if bp == nil {
-		t.Fatal("New() returned nil")
+		t.Fatal("New(clock.Real()) returned nil")
 	}
 	if bp.closed {
-		t.Error("New() closed = true, want false")
+		t.Error("New(clock.Real()) closed = true, want false")
 	}
 	if len(bp.entries) != 0 {
-		t.Errorf("New() entries length = %d, want 0", len(bp.entries))
+		t.Errorf("New(clock.Real()) entries length = %d, want 0", len(bp.entries))
 	}
 	if len(bp.pressedUsers) != 0 {
-		t.Errorf("New() pressedUsers length = %d, want 0", len(bp.pressedUsers))
+		t.Errorf("New(clock.Real()) pressedUsers length = %d, want 0", len(bp.pressedUsers))
 	}
 }
 
 func TestButtonPress_Reset(t *testing.T) {
-	bp := New()
+	bp := New(clock.Real())
 	userID := uuid.New()
 
 	bp.Press(userID, "user1", 100*time.Millisecond)
@@ -48,7 +49,7 @@ func TestButtonPress_Reset(t *testing.T) {
 }
 
 func TestButtonPress_Press(t *testing.T) {
-	bp := New()
+	bp := New(clock.Real())
 	bp.Reset()
 
 	userID := uuid.New()
@@ -69,7 +70,7 @@ func TestButtonPress_Press(t *testing.T) {
 }
 
 func TestButtonPress_Press_Duplicate(t *testing.T) {
-	bp := New()
+	bp := New(clock.Real())
 	bp.Reset()
 
 	userID := uuid.New()
@@ -87,7 +88,7 @@ func TestButtonPress_Press_Duplicate(t *testing.T) {
 }
 
 func TestButtonPress_Press_Closed(t *testing.T) {
-	bp := New()
+	bp := New(clock.Real())
 	bp.Reset()
 	bp.Close()
 
@@ -103,7 +104,7 @@ func TestButtonPress_Press_Closed(t *testing.T) {
 }
 
 func TestButtonPress_Close(t *testing.T) {
-	bp := New()
+	bp := New(clock.Real())
 	bp.Reset()
 
 	bp.Close()
@@ -114,7 +115,7 @@ func TestButtonPress_Close(t *testing.T) {
 }
 
 func TestButtonPress_IsClosed(t *testing.T) {
-	bp := New()
+	bp := New(clock.Real())
 	bp.Reset()
 
 	if bp.IsClosed() {
@@ -129,7 +130,7 @@ func TestButtonPress_IsClosed(t *testing.T) {
 }
 
 func TestButtonPress_HasPresses(t *testing.T) {
-	bp := New()
+	bp := New(clock.Real())
 	bp.Reset()
 
 	if bp.HasPresses() {
@@ -144,7 +145,7 @@ func TestButtonPress_HasPresses(t *testing.T) {
 }
 
 func TestButtonPress_GetPressCount(t *testing.T) {
-	bp := New()
+	bp := New(clock.Real())
 	bp.Reset()
 
 	if bp.GetPressCount() != 0 {
@@ -160,7 +161,7 @@ func TestButtonPress_GetPressCount(t *testing.T) {
 }
 
 func TestButtonPress_GetWinner(t *testing.T) {
-	bp := New()
+	bp := New(clock.Real())
 	bp.Reset()
 
 	if bp.GetWinner() != nil {
@@ -198,7 +199,7 @@ func TestButtonPress_GetWinner(t *testing.T) {
 }
 
 func TestButtonPress_GetAllPresses(t *testing.T) {
-	bp := New()
+	bp := New(clock.Real())
 	bp.Reset()
 
 	if bp.GetAllPresses() != nil {
@@ -229,7 +230,7 @@ func TestButtonPress_GetAllPresses(t *testing.T) {
 }
 
 func TestButtonPress_GetReactionTime(t *testing.T) {
-	bp := New()
+	bp := New(clock.Real())
 	bp.Reset()
 
 	entry := &PressEntry{
@@ -259,7 +260,7 @@ func TestButtonPress_GetReactionTime(t *testing.T) {
 }
 
 func TestButtonPress_GetReactionTime_NilEntry(t *testing.T) {
-	bp := New()
+	bp := New(clock.Real())
 	bp.Reset()
 
 	if bp.GetReactionTime(nil) != 0 {
@@ -268,7 +269,7 @@ func TestButtonPress_GetReactionTime_NilEntry(t *testing.T) {
 }
 
 func TestButtonPress_GetQuestionTime(t *testing.T) {
-	bp := New()
+	bp := New(clock.Real())
 
 	if !bp.GetQuestionTime().IsZero() {
 		t.Error("GetQuestionTime() before Reset() = non-zero, want zero")
@@ -282,7 +283,7 @@ func TestButtonPress_GetQuestionTime(t *testing.T) {
 }
 
 func TestButtonPress_RTTCompensation(t *testing.T) {
-	bp := New()
+	bp := New(clock.Real())
 	bp.Reset()
 
 	userID := uuid.New()
@@ -310,3 +311,214 @@ func TestButtonPress_RTTCompensation(t *testing.T) {
 	}
 }
 
+
+func TestButtonPress_GetReactionTime_FakeClock(t *testing.T) {
+	fake := clock.NewFake(time.Unix(0, 0))
+	bp := New(fake)
+	bp.Reset()
+
+	fake.Advance(750 * time.Millisecond)
+
+	userID := uuid.New()
+	bp.Press(userID, "user1", 100*time.Millisecond)
+
+	presses := bp.GetAllPresses()
+	if len(presses) != 1 {
+		t.Fatalf("Expected 1 press, got %d", len(presses))
+	}
+
+	if reactionTime := bp.GetReactionTime(&presses[0]); reactionTime != 700 {
+		t.Errorf("GetReactionTime() = %d, want 700", reactionTime)
+	}
+}
+
+func TestButtonPress_Exclude(t *testing.T) {
+	bp := New(clock.Real())
+	bp.Reset()
+
+	excludedID := uuid.New()
+	otherID := uuid.New()
+	bp.Exclude(excludedID)
+
+	if bp.Press(excludedID, "excluded", 0) {
+		t.Error("Press() by excluded user = true, want false")
+	}
+	if !bp.Press(otherID, "other", 0) {
+		t.Error("Press() by other user = false, want true")
+	}
+	if !bp.IsExcluded(excludedID) {
+		t.Error("IsExcluded() = false, want true")
+	}
+}
+
+func TestButtonPress_Withdraw(t *testing.T) {
+	fake := clock.NewFake(time.Unix(0, 0))
+	bp := New(fake)
+	bp.Reset()
+
+	firstID := uuid.New()
+	secondID := uuid.New()
+	bp.Press(firstID, "first", 0)
+	fake.Advance(10 * time.Millisecond)
+	bp.Press(secondID, "second", 0)
+
+	bp.Withdraw(firstID)
+
+	if winner := bp.GetWinner(); winner == nil || winner.UserID != secondID {
+		t.Errorf("GetWinner() after Withdraw() = %v, want %s", winner, secondID)
+	}
+	if bp.GetPressCount() != 1 {
+		t.Errorf("GetPressCount() = %d, want 1", bp.GetPressCount())
+	}
+	if bp.Press(firstID, "first", 0) {
+		t.Error("Press() after Withdraw() = true, want false")
+	}
+}
+
+func TestButtonPress_Reopen_KeepsExclusions(t *testing.T) {
+	bp := New(clock.Real())
+	bp.Reset()
+
+	first := uuid.New()
+	second := uuid.New()
+	bp.Press(first, "first", 0)
+	bp.Close()
+	bp.Exclude(first)
+
+	bp.Reopen()
+
+	if bp.IsClosed() {
+		t.Error("Reopen() IsClosed() = true, want false")
+	}
+	if bp.HasPresses() {
+		t.Error("Reopen() HasPresses() = true, want false")
+	}
+	if bp.Press(first, "first", 0) {
+		t.Error("Press() by excluded user after Reopen() = true, want false")
+	}
+	if !bp.Press(second, "second", 0) {
+		t.Error("Press() by other user after Reopen() = false, want true")
+	}
+	if excluded := bp.Excluded(); len(excluded) != 1 || excluded[0] != first {
+		t.Errorf("Excluded() = %v, want [%v]", excluded, first)
+	}
+
+	bp.Reset()
+
+	if len(bp.Excluded()) != 0 {
+		t.Errorf("Reset() Excluded() length = %d, want 0", len(bp.Excluded()))
+	}
+}
+
+func TestButtonPress_FalseStartLockout(t *testing.T) {
+	fake := clock.NewFake(time.Unix(0, 0))
+	bp := New(fake)
+	bp.Reset()
+
+	userID := uuid.New()
+	if !bp.RecordFalseStart(userID, "eager") {
+		t.Fatal("RecordFalseStart() = false, want true")
+	}
+	if bp.RecordFalseStart(userID, "eager") {
+		t.Error("RecordFalseStart() duplicate = true, want false")
+	}
+
+	fake.Advance(2 * time.Second)
+	bp.Open(time.Second)
+
+	falseStarts := bp.FalseStarts()
+	if len(falseStarts) != 1 || !falseStarts[0].FalseStart {
+		t.Fatalf("FalseStarts() = %v, want one false start entry", falseStarts)
+	}
+	if want := time.Unix(3, 0); !falseStarts[0].LockedUntil.Equal(want) {
+		t.Errorf("LockedUntil = %v, want %v", falseStarts[0].LockedUntil, want)
+	}
+
+	if !bp.IsLockedOut(userID) {
+		t.Error("IsLockedOut() = false, want true")
+	}
+	if bp.Press(userID, "eager", 0) {
+		t.Error("Press() during lockout = true, want false")
+	}
+
+	fake.Advance(time.Second)
+
+	if bp.IsLockedOut(userID) {
+		t.Error("IsLockedOut() after lockout = true, want false")
+	}
+	if !bp.Press(userID, "eager", 0) {
+		t.Error("Press() after lockout = false, want true")
+	}
+
+	bp.Reset()
+
+	if len(bp.FalseStarts()) != 0 {
+		t.Errorf("Reset() FalseStarts() length = %d, want 0", len(bp.FalseStarts()))
+	}
+}
+
+func TestButtonPress_ClientTimeCorrection(t *testing.T) {
+	offset := time.Hour
+
+	tests := []struct {
+		name           string
+		elapsed        time.Duration
+		rtt            time.Duration
+		pressedAt      time.Duration
+		skew           time.Duration
+		noTiming       bool
+		wantReaction   int64
+		wantSuspicious bool
+	}{
+		{name: "without client time", elapsed: 2 * time.Second, rtt: 100 * time.Millisecond, noTiming: true, wantReaction: 1950},
+		{name: "corrected client time", elapsed: 2 * time.Second, rtt: 100 * time.Millisecond, pressedAt: 1920 * time.Millisecond, wantReaction: 1920},
+		{name: "asymmetric latency", elapsed: 2 * time.Second, rtt: 400 * time.Millisecond, pressedAt: 1700 * time.Millisecond, wantReaction: 1700},
+		{name: "slightly in the future", elapsed: 2 * time.Second, rtt: 20 * time.Millisecond, pressedAt: 2020 * time.Millisecond, wantReaction: 2000},
+		{name: "far in the future", elapsed: 2 * time.Second, rtt: 100 * time.Millisecond, pressedAt: 3 * time.Second, wantReaction: 1950, wantSuspicious: true},
+		{name: "forged client_time", elapsed: 2 * time.Second, rtt: 100 * time.Millisecond, pressedAt: 1600 * time.Millisecond, wantReaction: 1950, wantSuspicious: true},
+		{name: "offset skewed by forged server_time", elapsed: 2 * time.Second, rtt: 100 * time.Millisecond, pressedAt: 1950 * time.Millisecond, skew: 400 * time.Millisecond, wantReaction: 1950, wantSuspicious: true},
+		{name: "compensation capped", elapsed: 2 * time.Second, rtt: 2 * time.Second, pressedAt: 1200 * time.Millisecond, wantReaction: 1500, wantSuspicious: true},
+		{name: "just before buttons opened", elapsed: 300 * time.Millisecond, rtt: 600 * time.Millisecond, pressedAt: -20 * time.Millisecond, wantReaction: 0},
+		{name: "well before buttons opened", elapsed: 300 * time.Millisecond, rtt: 600 * time.Millisecond, pressedAt: -150 * time.Millisecond, wantReaction: 0, wantSuspicious: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := clock.NewFake(time.Unix(1000, 0))
+			bp := New(fake)
+			bp.Reset()
+			openedAt := fake.Now()
+			fake.Advance(tt.elapsed)
+
+			var timing *ClientTiming
+			if !tt.noTiming {
+				timing = &ClientTiming{PressedAt: openedAt.Add(tt.pressedAt + offset), Offset: offset + tt.skew}
+			}
+
+			entry, ok := bp.PressWithClientTime(uuid.New(), "user1", tt.rtt, timing)
+			if !ok {
+				t.Fatal("PressWithClientTime() = false, want true")
+			}
+			if reaction := bp.GetReactionTime(&entry); reaction != tt.wantReaction {
+				t.Errorf("GetReactionTime() = %d, want %d", reaction, tt.wantReaction)
+			}
+			if entry.Suspicious != tt.wantSuspicious {
+				t.Errorf("Suspicious = %v, want %v", entry.Suspicious, tt.wantSuspicious)
+			}
+		})
+	}
+}
+
+func TestButtonPress_RTTCompensationCapped(t *testing.T) {
+	fake := clock.NewFake(time.Unix(0, 0))
+	bp := New(fake)
+	bp.Reset()
+	fake.Advance(3 * time.Second)
+
+	bp.Press(uuid.New(), "user1", 4*time.Second)
+
+	presses := bp.GetAllPresses()
+	if reaction := bp.GetReactionTime(&presses[0]); reaction != 2500 {
+		t.Errorf("GetReactionTime() = %d, want 2500", reaction)
+	}
+}
@@ -0,0 +1,39 @@
+package clock
+
+import "time"
+
+type Clock interface {
+	Now() time.Time
+	NewTimer(d time.Duration) Timer
+}
+
+type Timer interface {
+	C() <-chan time.Time
+	Stop() bool
+}
+
+type realClock struct{}
+
+func Real() Clock {
+	return realClock{}
+}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) NewTimer(d time.Duration) Timer {
+	return &realTimer{timer: time.NewTimer(d)}
+}
+
+type realTimer struct {
+	timer *time.Timer
+}
+
+func (t *realTimer) C() <-chan time.Time {
+	return t.timer.C
+}
+
+func (t *realTimer) Stop() bool {
+	return t.timer.Stop()
+}
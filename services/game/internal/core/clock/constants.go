@@ -0,0 +1,5 @@
+package clock
+
+const (
+	TimerChannelBuffer = 1
+)
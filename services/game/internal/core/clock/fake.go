@@ -0,0 +1,103 @@
+package clock
+
+import (
+	"sync"
+	"time"
+)
+
+type Fake struct {
+	now    time.Time
+	timers []*fakeTimer
+	mu     sync.Mutex
+}
+
+type fakeTimer struct {
+	clock    *Fake
+	deadline time.Time
+	c        chan time.Time
+	active   bool
+}
+
+func NewFake(start time.Time) *Fake {
+	return &Fake{now: start}
+}
+
+func (f *Fake) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.now
+}
+
+func (f *Fake) NewTimer(d time.Duration) Timer {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	t := &fakeTimer{
+		clock:    f,
+		deadline: f.now.Add(d),
+		c:        make(chan time.Time, TimerChannelBuffer),
+		active:   true,
+	}
+
+	if d <= 0 {
+		t.fire(f.now)
+		return t
+	}
+
+	f.timers = append(f.timers, t)
+	return t
+}
+
+func (f *Fake) Advance(d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.now = f.now.Add(d)
+
+	pending := f.timers[:0]
+	for _, t := range f.timers {
+		if !t.active {
+			continue
+		}
+		if t.deadline.After(f.now) {
+			pending = append(pending, t)
+			continue
+		}
+		t.fire(f.now)
+	}
+	f.timers = pending
+}
+
+func (f *Fake) PendingTimers() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	count := 0
+	for _, t := range f.timers {
+		if t.active {
+			count++
+		}
+	}
+	return count
+}
+
+func (t *fakeTimer) fire(now time.Time) {
+	t.active = false
+	select {
+	case t.c <- now:
+	default:
+	}
+}
+
+func (t *fakeTimer) C() <-chan time.Time {
+	return t.c
+}
+
+func (t *fakeTimer) Stop() bool {
+	t.clock.mu.Lock()
+	defer t.clock.mu.Unlock()
+
+	wasActive := t.active
+	t.active = false
+	return wasActive
+}
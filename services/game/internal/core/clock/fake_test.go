@@ -0,0 +1,77 @@
+package clock
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFake_Now(t *testing.T) {
+	start := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	fake := NewFake(start)
+
+	if !fake.Now().Equal(start) {
+		t.Errorf("Now() = %v, want %v", fake.Now(), start)
+	}
+
+	fake.Advance(5 * time.Second)
+
+	if want := start.Add(5 * time.Second); !fake.Now().Equal(want) {
+		t.Errorf("Now() after Advance = %v, want %v", fake.Now(), want)
+	}
+}
+
+func TestFake_TimerFiresOnAdvance(t *testing.T) {
+	fake := NewFake(time.Unix(0, 0))
+	timer := fake.NewTimer(time.Second)
+
+	fake.Advance(999 * time.Millisecond)
+	select {
+	case <-timer.C():
+		t.Fatal("timer fired before its deadline")
+	default:
+	}
+
+	fake.Advance(time.Millisecond)
+	select {
+	case tick := <-timer.C():
+		if !tick.Equal(time.Unix(1, 0)) {
+			t.Errorf("tick = %v, want %v", tick, time.Unix(1, 0))
+		}
+	default:
+		t.Fatal("timer did not fire at its deadline")
+	}
+
+	if fake.PendingTimers() != 0 {
+		t.Errorf("PendingTimers() = %d, want 0", fake.PendingTimers())
+	}
+}
+
+func TestFake_StoppedTimerDoesNotFire(t *testing.T) {
+	fake := NewFake(time.Unix(0, 0))
+	timer := fake.NewTimer(time.Second)
+
+	if !timer.Stop() {
+		t.Error("Stop() on active timer = false, want true")
+	}
+	if timer.Stop() {
+		t.Error("Stop() on stopped timer = true, want false")
+	}
+
+	fake.Advance(time.Minute)
+	select {
+	case <-timer.C():
+		t.Fatal("stopped timer fired")
+	default:
+	}
+}
+
+func TestFake_ZeroDurationFiresImmediately(t *testing.T) {
+	fake := NewFake(time.Unix(0, 0))
+	timer := fake.NewTimer(0)
+
+	select {
+	case <-timer.C():
+	default:
+		t.Fatal("zero duration timer did not fire immediately")
+	}
+}
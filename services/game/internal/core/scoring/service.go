@@ -0,0 +1,87 @@
+package scoring
+
+import (
+	"math"
+
+	domainGame "sigame/game/internal/domain/game"
+	"sigame/game/internal/domain/player"
+)
+
+type Service struct {
+	rules domainGame.ScoringRules
+}
+
+func NewService(rules domainGame.ScoringRules) *Service {
+	return &Service{rules: rules}
+}
+
+func (s *Service) QuestionValue(price, roundNumber int) int {
+	return scale(price, s.rules.RoundMultiplier(roundNumber))
+}
+
+func (s *Service) Correct(p *player.Player, value int) int {
+	return s.apply(p, value)
+}
+
+func (s *Service) Wrong(p *player.Player, value int) int {
+	return s.apply(p, -scale(value, s.rules.GetWrongAnswerPenalty()))
+}
+
+func (s *Service) Timeout(p *player.Player, value int) int {
+	if s.rules.NoTimeoutPenalty {
+		return 0
+	}
+	return s.Wrong(p, value)
+}
+
+func (s *Service) ForAll(p *player.Player, correct bool, value int) int {
+	if correct {
+		return s.Correct(p, value)
+	}
+	if s.rules.NoForAllPenalty {
+		return 0
+	}
+	return s.Wrong(p, value)
+}
+
+func (s *Service) Stake(p *player.Player, correct bool, stake int) int {
+	if correct {
+		return s.apply(p, stake)
+	}
+	return s.apply(p, -stake)
+}
+
+func (s *Service) StakeTimeout(p *player.Player, stake int) int {
+	if s.rules.NoTimeoutPenalty {
+		return 0
+	}
+	return s.Stake(p, false, stake)
+}
+
+func (s *Service) Adjust(p *player.Player, delta int) int {
+	return s.apply(p, delta)
+}
+
+func (s *Service) StakeLimit(p *player.Player, minBet, maxStake int) int {
+	limit := p.Score
+	if maxStake > 0 && limit > maxStake {
+		limit = maxStake
+	}
+	if limit < minBet {
+		limit = minBet
+	}
+	return limit
+}
+
+func (s *Service) apply(p *player.Player, delta int) int {
+	before := p.Score
+	p.AddScore(delta)
+	if !s.rules.AllowNegative && p.Score < 0 && delta < 0 {
+		p.Score = min(before, 0)
+	}
+	return p.Score - before
+}
+
+func scale(value int, multiplier float64) int {
+	return int(math.Round(float64(value) * multiplier))
+}
@@ -0,0 +1,105 @@
+package scoring
+
+import (
+	"testing"
+
+	"github.com/google/uuid"
+	domainGame "sigame/game/internal/domain/game"
+	"sigame/game/internal/domain/player"
+)
+
+func TestService_DefaultRulesClampAtZero(t *testing.T) {
+	service := NewService(domainGame.ScoringRules{})
+	p := &player.Player{UserID: uuid.New(), Username: "p", Score: 100}
+
+	if delta := service.Wrong(p, 300); delta != -100 || p.Score != 0 {
+		t.Errorf("Wrong() delta = %d, score = %d, want -100 and 0", delta, p.Score)
+	}
+	if delta := service.Correct(p, 200); delta != 200 || p.Score != 200 {
+		t.Errorf("Correct() delta = %d, score = %d, want 200 and 200", delta, p.Score)
+	}
+	if delta := service.Timeout(p, 100); delta != -100 || p.Score != 100 {
+		t.Errorf("Timeout() delta = %d, score = %d, want -100 and 100", delta, p.Score)
+	}
+}
+
+func TestService_AllowNegative(t *testing.T) {
+	service := NewService(domainGame.ScoringRules{AllowNegative: true})
+	p := &player.Player{UserID: uuid.New(), Username: "p", Score: 100}
+
+	if delta := service.Wrong(p, 300); delta != -300 || p.Score != -200 {
+		t.Errorf("Wrong() delta = %d, score = %d, want -300 and -200", delta, p.Score)
+	}
+	if delta := service.Stake(p, false, 500); delta != -500 || p.Score != -700 {
+		t.Errorf("Stake() delta = %d, score = %d, want -500 and -700", delta, p.Score)
+	}
+}
+
+func TestService_Adjust(t *testing.T) {
+	p := &player.Player{UserID: uuid.New(), Username: "p", Score: 100}
+
+	if delta := NewService(domainGame.ScoringRules{}).Adjust(p, -300); delta != -100 || p.Score != 0 {
+		t.Errorf("Adjust() delta = %d, score = %d, want -100 and 0", delta, p.Score)
+	}
+	if delta := NewService(domainGame.ScoringRules{AllowNegative: true}).Adjust(p, -300); delta != -300 || p.Score != -300 {
+		t.Errorf("Adjust() with negative scores delta = %d, score = %d, want -300 and -300", delta, p.Score)
+	}
+	if delta := NewService(domainGame.ScoringRules{}).Adjust(p, 500); delta != 500 || p.Score != 200 {
+		t.Errorf("Adjust() delta = %d, score = %d, want 500 and 200", delta, p.Score)
+	}
+}
+
+func TestService_PenaltiesAndMultipliers(t *testing.T) {
+	half := 0.5
+	service := NewService(domainGame.ScoringRules{
+		WrongAnswerPenalty: &half,
+		NoTimeoutPenalty:   true,
+		NoForAllPenalty:    true,
+		RoundMultipliers:   []float64{1, 2},
+	})
+	p := &player.Player{UserID: uuid.New(), Username: "p", Score: 1000}
+
+	if value := service.QuestionValue(300, 2); value != 600 {
+		t.Errorf("QuestionValue() round 2 = %d, want 600", value)
+	}
+	if value := service.QuestionValue(300, 3); value != 300 {
+		t.Errorf("QuestionValue() round 3 = %d, want 300", value)
+	}
+	if delta := service.Wrong(p, 300); delta != -150 {
+		t.Errorf("Wrong() with half penalty = %d, want -150", delta)
+	}
+	if delta := service.Timeout(p, 300); delta != 0 {
+		t.Errorf("Timeout() without timeout penalty = %d, want 0", delta)
+	}
+	if delta := service.StakeTimeout(p, 300); delta != 0 {
+		t.Errorf("StakeTimeout() without timeout penalty = %d, want 0", delta)
+	}
+	if delta := service.ForAll(p, false, 300); delta != 0 {
+		t.Errorf("ForAll() wrong without for all penalty = %d, want 0", delta)
+	}
+	if delta := service.ForAll(p, true, 300); delta != 300 {
+		t.Errorf("ForAll() correct = %d, want 300", delta)
+	}
+}
+
+func TestService_StakeLimit(t *testing.T) {
+	service := NewService(domainGame.ScoringRules{AllowNegative: true})
+
+	tests := []struct {
+		score    int
+		maxStake int
+		want     int
+	}{
+		{score: 1500, maxStake: 0, want: 1500},
+		{score: 1500, maxStake: 1000, want: 1000},
+		{score: 100, maxStake: 0, want: 300},
+		{score: -400, maxStake: 0, want: 300},
+	}
+
+	for _, tt := range tests {
+		p := &player.Player{UserID: uuid.New(), Username: "p", Score: tt.score}
+		if got := service.StakeLimit(p, 300, tt.maxStake); got != tt.want {
+			t.Errorf("StakeLimit(score=%d, maxStake=%d) = %d, want %d", tt.score, tt.maxStake, got, tt.want)
+		}
+	}
+}
@@ -3,21 +3,27 @@ package timer
 import (
 	"sync"
 	"time"
+
+	"sigame/game/internal/core/clock"
 )
 
 type Timer struct {
 	C         chan time.Time
-	timer     *time.Timer
+	clock     clock.Clock
+	timer     clock.Timer
 	active    bool
 	mu        sync.Mutex
 	stopped   chan struct{}
 	startedAt time.Time
 	duration  time.Duration
+	paused    bool
+	remaining time.Duration
 }
 
-func New() *Timer {
+func New(clk clock.Clock) *Timer {
 	return &Timer{
 		C:       make(chan time.Time, ChannelBufferSize),
+		clock:   clk,
 		active:  false,
 		stopped: make(chan struct{}),
 	}
@@ -28,20 +34,27 @@ func (t *Timer) Start(duration time.Duration) {
 	defer t.mu.Unlock()
 
 	t.stopInternal()
+	t.paused = false
+	t.startInternal(duration)
+}
 
-	t.timer = time.NewTimer(duration)
+func (t *Timer) startInternal(duration time.Duration) {
+	t.timer = t.clock.NewTimer(duration)
+	t.stopped = make(chan struct{})
 	t.active = true
-	t.startedAt = time.Now()
+	t.startedAt = t.clock.Now()
 	t.duration = duration
 
+	fired := t.timer.C()
+	stopped := t.stopped
 	go func() {
 		select {
-		case tick := <-t.timer.C:
+		case tick := <-fired:
 			select {
 			case t.C <- tick:
 			default:
 			}
-		case <-t.stopped:
+		case <-stopped:
 		}
 	}()
 }
@@ -50,17 +63,48 @@ func (t *Timer) Stop() {
 	t.mu.Lock()
 	defer t.mu.Unlock()
 	t.stopInternal()
+	t.paused = false
+}
+
+func (t *Timer) Pause() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if !t.active || t.paused {
+		return
+	}
+
+	t.remaining = t.duration - t.clock.Now().Sub(t.startedAt)
+	if t.remaining < 0 {
+		t.remaining = 0
+	}
+	t.stopInternal()
+	t.paused = true
+}
+
+func (t *Timer) Resume() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if !t.paused {
+		return
+	}
+
+	t.paused = false
+	t.startInternal(t.remaining)
+}
+
+func (t *Timer) IsPaused() bool {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.paused
 }
 
 func (t *Timer) stopInternal() {
 	if t.timer != nil && t.active {
 		t.timer.Stop()
 		t.active = false
-
-		select {
-		case t.stopped <- struct{}{}:
-		default:
-		}
+		close(t.stopped)
 
 		select {
 		case <-t.C:
@@ -79,14 +123,37 @@ func (t *Timer) Remaining() int {
 	t.mu.Lock()
 	defer t.mu.Unlock()
 
+	if t.paused {
+		return int(t.remaining.Seconds())
+	}
+
 	if !t.active {
 		return InactiveRemaining
 	}
 
-	elapsed := time.Since(t.startedAt)
+	elapsed := t.clock.Now().Sub(t.startedAt)
 	remaining := t.duration - elapsed
 	if remaining < 0 {
 		return InactiveRemaining
 	}
 	return int(remaining.Seconds())
 }
+
+func (t *Timer) RemainingDuration() time.Duration {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.paused {
+		return t.remaining
+	}
+
+	if !t.active {
+		return 0
+	}
+
+	remaining := t.duration - t.clock.Now().Sub(t.startedAt)
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
+}
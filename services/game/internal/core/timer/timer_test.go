@@ -3,10 +3,12 @@ package timer
 import (
 	"testing"
 	"time"
+
+	"sigame/game/internal/core/clock"
 )
 
 func TestNew(t *testing.T) {
-	timer := New()
+	timer := New(clock.Real())
 
 	if timer == nil {
 		t.Fatal("New returned nil")
@@ -30,7 +32,7 @@ func TestNew(t *testing.T) {
 }
 
 func TestTimer_Start(t *testing.T) {
-	timer := New()
+	timer := New(clock.Real())
 	duration := 100 * time.Millisecond
 
 	timer.Start(duration)
@@ -48,7 +50,7 @@ func TestTimer_Start(t *testing.T) {
 }
 
 func TestTimer_Start_StopsPrevious(t *testing.T) {
-	timer := New()
+	timer := New(clock.Real())
 	firstDuration := 200 * time.Millisecond
 	secondDuration := 100 * time.Millisecond
 
@@ -80,7 +82,7 @@ func TestTimer_Start_StopsPrevious(t *testing.T) {
 }
 
 func TestTimer_Stop(t *testing.T) {
-	timer := New()
+	timer := New(clock.Real())
 	duration := 200 * time.Millisecond
 
 	timer.Start(duration)
@@ -104,7 +106,7 @@ func TestTimer_Stop(t *testing.T) {
 }
 
 func TestTimer_IsActive(t *testing.T) {
-	timer := New()
+	timer := New(clock.Real())
 
 	if timer.IsActive() {
 		t.Error("New timer should not be active")
@@ -124,7 +126,7 @@ func TestTimer_IsActive(t *testing.T) {
 }
 
 func TestTimer_Remaining(t *testing.T) {
-	timer := New()
+	timer := New(clock.Real())
 
 	remaining := timer.Remaining()
 	if remaining != InactiveRemaining {
@@ -155,7 +157,7 @@ func TestTimer_Remaining(t *testing.T) {
 }
 
 func TestTimer_Channel(t *testing.T) {
-	timer := New()
+	timer := New(clock.Real())
 	duration := 50 * time.Millisecond
 
 	timer.Start(duration)
@@ -184,7 +186,7 @@ func TestTimer_Channel(t *testing.T) {
 }
 
 func TestTimer_Remaining_Negative(t *testing.T) {
-	timer := New()
+	timer := New(clock.Real())
 	duration := 10 * time.Millisecond
 
 	timer.Start(duration)
@@ -197,7 +199,7 @@ func TestTimer_Remaining_Negative(t *testing.T) {
 }
 
 func TestTimer_MultipleStarts(t *testing.T) {
-	timer := New()
+	timer := New(clock.Real())
 
 	for i := 0; i < 5; i++ {
 		timer.Start(50 * time.Millisecond)
@@ -214,3 +216,113 @@ func TestTimer_MultipleStarts(t *testing.T) {
 	}
 }
 
+
+func TestTimer_PauseResume(t *testing.T) {
+	timer := New(clock.Real())
+	timer.Start(100 * time.Millisecond)
+
+	time.Sleep(40 * time.Millisecond)
+	timer.Pause()
+
+	if !timer.IsPaused() {
+		t.Error("Timer should be paused after Pause")
+	}
+	if timer.IsActive() {
+		t.Error("Paused timer should not be active")
+	}
+
+	select {
+	case <-timer.C:
+		t.Error("Timer should not fire while paused")
+	case <-time.After(150 * time.Millisecond):
+	}
+
+	resumedAt := time.Now()
+	timer.Resume()
+
+	if timer.IsPaused() {
+		t.Error("Timer should not be paused after Resume")
+	}
+
+	select {
+	case <-timer.C:
+		if elapsed := time.Since(resumedAt); elapsed > 90*time.Millisecond {
+			t.Errorf("Timer fired %v after Resume, want remaining time only", elapsed)
+		}
+	case <-time.After(200 * time.Millisecond):
+		t.Error("Timer did not fire after Resume")
+	}
+}
+
+func TestTimer_Remaining_Paused(t *testing.T) {
+	timer := New(clock.Real())
+	timer.Start(3 * time.Second)
+	timer.Pause()
+
+	time.Sleep(50 * time.Millisecond)
+
+	if remaining := timer.Remaining(); remaining != 2 {
+		t.Errorf("Expected remaining 2 while paused, got %d", remaining)
+	}
+
+	timer.Stop()
+
+	if timer.IsPaused() {
+		t.Error("Timer should not be paused after Stop")
+	}
+}
+
+func TestTimer_FakeClock(t *testing.T) {
+	fake := clock.NewFake(time.Unix(0, 0))
+	timer := New(fake)
+	timer.Start(10 * time.Second)
+
+	fake.Advance(4 * time.Second)
+	if remaining := timer.Remaining(); remaining != 6 {
+		t.Errorf("Expected remaining 6, got %d", remaining)
+	}
+
+	timer.Pause()
+	fake.Advance(time.Minute)
+	timer.Resume()
+
+	fake.Advance(5 * time.Second)
+	select {
+	case <-timer.C:
+		t.Fatal("Timer fired before the paused remainder elapsed")
+	default:
+	}
+
+	fake.Advance(time.Second)
+	select {
+	case <-timer.C:
+	case <-time.After(time.Second):
+		t.Fatal("Timer did not fire after the fake clock advanced past its deadline")
+	}
+}
+
+func TestTimer_RemainingDuration(t *testing.T) {
+	fake := clock.NewFake(time.Unix(0, 0))
+	timer := New(fake)
+
+	if got := timer.RemainingDuration(); got != 0 {
+		t.Errorf("RemainingDuration() before Start = %v, want 0", got)
+	}
+
+	timer.Start(10 * time.Second)
+	fake.Advance(2500 * time.Millisecond)
+	if got := timer.RemainingDuration(); got != 7500*time.Millisecond {
+		t.Errorf("RemainingDuration() = %v, want 7.5s", got)
+	}
+
+	timer.Pause()
+	fake.Advance(time.Second)
+	if got := timer.RemainingDuration(); got != 7500*time.Millisecond {
+		t.Errorf("RemainingDuration() while paused = %v, want 7.5s", got)
+	}
+
+	timer.Stop()
+	if got := timer.RemainingDuration(); got != 0 {
+		t.Errorf("RemainingDuration() after Stop = %v, want 0", got)
+	}
+}
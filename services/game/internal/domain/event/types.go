@@ -7,6 +7,8 @@ const (
 	TypeGameStarted       Type = "GAME_STARTED"
 	TypeGameFinished      Type = "GAME_FINISHED"
 	TypeGameCancelled     Type = "GAME_CANCELLED"
+	TypeGamePaused        Type = "GAME_PAUSED"
+	TypeGameResumed       Type = "GAME_RESUMED"
 	TypePlayerJoined      Type = "PLAYER_JOINED"
 	TypePlayerLeft        Type = "PLAYER_LEFT"
 	TypePlayerReady       Type = "PLAYER_READY"
@@ -21,6 +23,13 @@ const (
 	TypeScoreChanged      Type = "SCORE_CHANGED"
 	TypeTimerStarted      Type = "TIMER_STARTED"
 	TypeTimerExpired      Type = "TIMER_EXPIRED"
+	TypeFinalThemeRemoved Type = "FINAL_THEME_REMOVED"
+	TypeFinalStakePlaced  Type = "FINAL_STAKE_PLACED"
+	TypeFinalAnswerSubmitted Type = "FINAL_ANSWER_SUBMITTED"
+	TypeScoreAdjusted     Type = "SCORE_ADJUSTED"
+	TypePlayerKicked      Type = "PLAYER_KICKED"
+	TypeQuestionSkipped   Type = "QUESTION_SKIPPED"
+	TypeRoundEndedByHost  Type = "ROUND_ENDED_BY_HOST"
 )
 
 func (t Type) String() string {
@@ -10,5 +10,25 @@ var (
 	ErrInvalidRound        = errors.New("invalid round number")
 	ErrHostNotFound        = errors.New("host not found")
 	ErrInvalidSettings     = errors.New("invalid game settings")
+	ErrGameNotRunning      = errors.New("game is not running")
+	ErrGameAlreadyPaused   = errors.New("game is already paused")
+	ErrGameNotPaused       = errors.New("game is not paused")
+
+	ErrIllegalTransition = errors.New("illegal status transition")
+	ErrTransitionGuard   = errors.New("status transition guard failed")
+	ErrNoCurrentQuestion = errors.New("no question is in play")
+	ErrNoActivePlayer    = errors.New("no active player")
+	ErrFinalNotStarted   = errors.New("final round is not started")
+
+	ErrNotStakeTurn = errors.New("not this player's turn to bid")
+	ErrStakeTooLow  = errors.New("stake is below the minimum bid")
+	ErrStakeTooHigh = errors.New("stake exceeds the player's limit")
+
+	ErrNotFinalParticipant  = errors.New("player is not a final round participant")
+	ErrNotFinalTurn         = errors.New("not this player's turn to remove a theme")
+	ErrFinalThemeRemoved    = errors.New("final theme already removed")
+	ErrFinalStakePlaced     = errors.New("final stake already placed")
+	ErrFinalAnswerSubmitted = errors.New("final answer already submitted")
+	ErrFinalAnswerJudged    = errors.New("final answer already judged")
 )
 
@@ -0,0 +1,130 @@
+package game
+
+import "github.com/google/uuid"
+
+type FinalRound struct {
+	Participants  []uuid.UUID
+	RemovedThemes []string
+	TurnIndex     int
+	Stakes        map[uuid.UUID]int
+	Answers       map[uuid.UUID]string
+	Verdicts      map[uuid.UUID]bool
+}
+
+func NewFinalRound(participants []uuid.UUID) *FinalRound {
+	return &FinalRound{
+		Participants:  participants,
+		RemovedThemes: make([]string, 0),
+		Stakes:        make(map[uuid.UUID]int),
+		Answers:       make(map[uuid.UUID]string),
+		Verdicts:      make(map[uuid.UUID]bool),
+	}
+}
+
+func (f *FinalRound) Clone() *FinalRound {
+	clone := &FinalRound{
+		Participants:  append([]uuid.UUID(nil), f.Participants...),
+		RemovedThemes: append([]string(nil), f.RemovedThemes...),
+		TurnIndex:     f.TurnIndex,
+		Stakes:        make(map[uuid.UUID]int, len(f.Stakes)),
+		Answers:       make(map[uuid.UUID]string, len(f.Answers)),
+		Verdicts:      make(map[uuid.UUID]bool, len(f.Verdicts)),
+	}
+	for userID, stake := range f.Stakes {
+		clone.Stakes[userID] = stake
+	}
+	for userID, answer := range f.Answers {
+		clone.Answers[userID] = answer
+	}
+	for userID, verdict := range f.Verdicts {
+		clone.Verdicts[userID] = verdict
+	}
+	return clone
+}
+
+func (f *FinalRound) IsParticipant(userID uuid.UUID) bool {
+	for _, id := range f.Participants {
+		if id == userID {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *FinalRound) CurrentTurn() uuid.UUID {
+	if len(f.Participants) == 0 {
+		return uuid.Nil
+	}
+	return f.Participants[f.TurnIndex%len(f.Participants)]
+}
+
+func (f *FinalRound) IsThemeRemoved(themeName string) bool {
+	for _, name := range f.RemovedThemes {
+		if name == themeName {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *FinalRound) RemoveTheme(userID uuid.UUID, themeName string) error {
+	if f.CurrentTurn() != userID {
+		return ErrNotFinalTurn
+	}
+	if f.IsThemeRemoved(themeName) {
+		return ErrFinalThemeRemoved
+	}
+	f.RemovedThemes = append(f.RemovedThemes, themeName)
+	f.TurnIndex++
+	return nil
+}
+
+func (f *FinalRound) PlaceStake(userID uuid.UUID, amount int) error {
+	if !f.IsParticipant(userID) {
+		return ErrNotFinalParticipant
+	}
+	if _, exists := f.Stakes[userID]; exists {
+		return ErrFinalStakePlaced
+	}
+	f.Stakes[userID] = amount
+	return nil
+}
+
+func (f *FinalRound) AllStaked() bool {
+	return len(f.Stakes) >= len(f.Participants)
+}
+
+func (f *FinalRound) SubmitAnswer(userID uuid.UUID, answer string) error {
+	if !f.IsParticipant(userID) {
+		return ErrNotFinalParticipant
+	}
+	if _, exists := f.Answers[userID]; exists {
+		return ErrFinalAnswerSubmitted
+	}
+	f.Answers[userID] = answer
+	return nil
+}
+
+func (f *FinalRound) AllAnswered() bool {
+	return len(f.Answers) >= len(f.Participants)
+}
+
+func (f *FinalRound) Judge(userID uuid.UUID, correct bool) error {
+	if !f.IsParticipant(userID) {
+		return ErrNotFinalParticipant
+	}
+	if _, exists := f.Verdicts[userID]; exists {
+		return ErrFinalAnswerJudged
+	}
+	f.Verdicts[userID] = correct
+	return nil
+}
+
+func (f *FinalRound) NextToJudge() (uuid.UUID, bool) {
+	for _, userID := range f.Participants {
+		if _, judged := f.Verdicts[userID]; !judged {
+			return userID, true
+		}
+	}
+	return uuid.Nil, false
+}
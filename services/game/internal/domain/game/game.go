@@ -13,13 +13,16 @@ type Game struct {
 	RoomID          uuid.UUID
 	PackID          uuid.UUID
 	Status          Status
+	Paused          bool
 	Players         map[uuid.UUID]*player.Player
+	Spectators      map[uuid.UUID]*player.Player
 	Rounds          []*pack.Round
 	CurrentRound    int
 	CurrentPhase    Status
 	ActivePlayer    *uuid.UUID
 	CurrentTheme    *string
 	CurrentQuestion *pack.Question
+	Final           *FinalRound
 	Settings        Settings
 	Winners         []player.Score
 	FinalScores     []player.Score
@@ -37,6 +40,7 @@ func New(roomID, packID uuid.UUID, settings Settings, rounds []*pack.Round) *Gam
 		PackID:       packID,
 		Status:       StatusWaiting,
 		Players:      make(map[uuid.UUID]*player.Player),
+		Spectators:   make(map[uuid.UUID]*player.Player),
 		Rounds:       rounds,
 		CurrentRound: 0,
 		CurrentPhase: StatusWaiting,
@@ -46,6 +50,49 @@ func New(roomID, packID uuid.UUID, settings Settings, rounds []*pack.Round) *Gam
 	}
 }
 
+func (g *Game) Clone() *Game {
+	clone := *g
+	clone.Players = clonePlayers(g.Players)
+	clone.Spectators = clonePlayers(g.Spectators)
+	clone.Winners = append([]player.Score(nil), g.Winners...)
+	clone.FinalScores = append([]player.Score(nil), g.FinalScores...)
+
+	if g.Rounds != nil {
+		clone.Rounds = make([]*pack.Round, len(g.Rounds))
+		for i, round := range g.Rounds {
+			clone.Rounds[i] = round.Clone()
+		}
+	}
+	if g.ActivePlayer != nil {
+		activePlayer := *g.ActivePlayer
+		clone.ActivePlayer = &activePlayer
+	}
+	if g.CurrentTheme != nil {
+		currentTheme := *g.CurrentTheme
+		clone.CurrentTheme = &currentTheme
+	}
+	if g.CurrentQuestion != nil {
+		currentQuestion := *g.CurrentQuestion
+		clone.CurrentQuestion = &currentQuestion
+	}
+	if g.Final != nil {
+		clone.Final = g.Final.Clone()
+	}
+	return &clone
+}
+
+func clonePlayers(players map[uuid.UUID]*player.Player) map[uuid.UUID]*player.Player {
+	if players == nil {
+		return nil
+	}
+	clone := make(map[uuid.UUID]*player.Player, len(players))
+	for userID, p := range players {
+		copied := *p
+		clone[userID] = &copied
+	}
+	return clone
+}
+
 func (g *Game) AddPlayer(p *player.Player) error {
 	if _, exists := g.Players[p.UserID]; exists {
 		return ErrPlayerAlreadyExists
@@ -55,6 +102,31 @@ func (g *Game) AddPlayer(p *player.Player) error {
 	return nil
 }
 
+func (g *Game) AddSpectator(p *player.Player) error {
+	if _, exists := g.Players[p.UserID]; exists {
+		return ErrPlayerAlreadyExists
+	}
+	if g.Spectators == nil {
+		g.Spectators = make(map[uuid.UUID]*player.Player)
+	}
+	g.Spectators[p.UserID] = p
+	g.UpdatedAt = time.Now()
+	return nil
+}
+
+func (g *Game) IsSpectator(userID uuid.UUID) bool {
+	_, exists := g.Spectators[userID]
+	return exists
+}
+
+func (g *Game) GetSpectator(userID uuid.UUID) (*player.Player, error) {
+	p, exists := g.Spectators[userID]
+	if !exists {
+		return nil, ErrPlayerNotFound
+	}
+	return p, nil
+}
+
 func (g *Game) GetPlayer(userID uuid.UUID) (*player.Player, error) {
 	p, exists := g.Players[userID]
 	if !exists {
@@ -91,12 +163,39 @@ func (g *Game) Cancel() {
 	g.UpdatedAt = time.Now()
 }
 
+func (g *Game) Pause() error {
+	if !g.Status.IsPlaying() || g.Status == StatusGameEnd {
+		return ErrGameNotRunning
+	}
+	if g.Paused {
+		return ErrGameAlreadyPaused
+	}
+
+	g.Paused = true
+	g.UpdatedAt = time.Now()
+	return nil
+}
+
+func (g *Game) Resume() error {
+	if !g.Paused {
+		return ErrGameNotPaused
+	}
+
+	g.Paused = false
+	g.UpdatedAt = time.Now()
+	return nil
+}
+
 func (g *Game) UpdateStatus(status Status) {
 	g.Status = status
 	g.CurrentPhase = status
 	g.UpdatedAt = time.Now()
 }
 
+func (g *Game) TransitionTo(status Status) error {
+	return Machine.Apply(g, status)
+}
+
 func (g *Game) SetActivePlayer(userID uuid.UUID) {
 	g.ActivePlayer = &userID
 }
@@ -0,0 +1,44 @@
+package game
+
+const (
+	DefaultWrongAnswerPenalty = 1.0
+	MaxWrongAnswerPenalty     = 5.0
+
+	DefaultRoundMultiplier = 1.0
+	MaxRoundMultiplier     = 10.0
+)
+
+type ScoringRules struct {
+	AllowNegative      bool      `json:"allow_negative,omitempty"`
+	WrongAnswerPenalty *float64  `json:"wrong_answer_penalty,omitempty"`
+	NoTimeoutPenalty   bool      `json:"no_timeout_penalty,omitempty"`
+	RoundMultipliers   []float64 `json:"round_multipliers,omitempty"`
+	NoForAllPenalty    bool      `json:"no_for_all_penalty,omitempty"`
+}
+
+func (r ScoringRules) GetWrongAnswerPenalty() float64 {
+	if r.WrongAnswerPenalty == nil {
+		return DefaultWrongAnswerPenalty
+	}
+	return *r.WrongAnswerPenalty
+}
+
+func (r ScoringRules) RoundMultiplier(roundNumber int) float64 {
+	if roundNumber < 1 || roundNumber > len(r.RoundMultipliers) || r.RoundMultipliers[roundNumber-1] == 0 {
+		return DefaultRoundMultiplier
+	}
+	return r.RoundMultipliers[roundNumber-1]
+}
+
+func (r ScoringRules) Validate() error {
+	penalty := r.GetWrongAnswerPenalty()
+	if penalty < 0 || penalty > MaxWrongAnswerPenalty {
+		return ErrInvalidSettings
+	}
+	for _, multiplier := range r.RoundMultipliers {
+		if multiplier < 0 || multiplier > MaxRoundMultiplier {
+			return ErrInvalidSettings
+		}
+	}
+	return nil
+}
@@ -1,15 +1,136 @@
 package game
 
+import "time"
+
+type AnswerMatchStrategy string
+
+const (
+	AnswerMatchExact        AnswerMatchStrategy = "exact"
+	AnswerMatchNormalized   AnswerMatchStrategy = "normalized"
+	AnswerMatchEditDistance AnswerMatchStrategy = "edit_distance"
+	AnswerMatchNumeric      AnswerMatchStrategy = "numeric"
+
+	DefaultAnswerMatch    = AnswerMatchNormalized
+	DefaultMatchThreshold = 0.8
+
+	MaxFalseStartLockoutMs = 5000
+
+	DefaultReadingSpeed = 20
+	MaxReadingSpeed     = 100
+
+	DefaultAnswerRevealMs = 5000
+	MaxAnswerRevealMs     = 30000
+)
+
+type SelectionPolicy string
+
+const (
+	SelectionByHost        SelectionPolicy = "host"
+	SelectionByLastCorrect SelectionPolicy = "last_correct"
+	SelectionRoundRobin    SelectionPolicy = "round_robin"
+
+	DefaultSelectionPolicy = SelectionByHost
+)
+
+func (p SelectionPolicy) String() string {
+	return string(p)
+}
+
+func (p SelectionPolicy) IsValid() bool {
+	switch p {
+	case SelectionByHost, SelectionByLastCorrect, SelectionRoundRobin:
+		return true
+	}
+	return false
+}
+
+func (s AnswerMatchStrategy) String() string {
+	return string(s)
+}
+
+func (s AnswerMatchStrategy) IsValid() bool {
+	switch s {
+	case AnswerMatchExact, AnswerMatchNormalized, AnswerMatchEditDistance, AnswerMatchNumeric:
+		return true
+	}
+	return false
+}
+
 type Settings struct {
-	TimeForAnswer int `json:"time_for_answer" binding:"required"`
-	TimeForChoice int `json:"time_for_choice" binding:"required"`
+	TimeForAnswer  int                 `json:"time_for_answer" binding:"required"`
+	TimeForChoice  int                 `json:"time_for_choice" binding:"required"`
+	AnswerMatch    AnswerMatchStrategy `json:"answer_match,omitempty"`
+	MatchThreshold float64             `json:"match_threshold,omitempty"`
+	ReopenButtons  bool                `json:"reopen_buttons,omitempty"`
+
+	FalseStartLockoutMs int `json:"false_start_lockout_ms,omitempty"`
+	ReadingSpeed        int `json:"reading_speed,omitempty"`
+
+	SelectionPolicy SelectionPolicy `json:"selection_policy,omitempty"`
+	AnswerRevealMs  int             `json:"answer_reveal_ms,omitempty"`
+
+	Scoring ScoringRules `json:"scoring"`
+
+	RoundsOverviewTime int `json:"rounds_overview_time,omitempty"`
+	RoundIntroTime     int `json:"round_intro_time,omitempty"`
+	JudgingTime        int `json:"judging_time,omitempty"`
+	SecretTransferTime int `json:"secret_transfer_time,omitempty"`
+	StakeBettingTime   int `json:"stake_betting_time,omitempty"`
+	ButtonWindowMs     int `json:"button_window_ms,omitempty"`
+	ResultsDisplayTime int `json:"results_display_time,omitempty"`
+
+	SecretPriceSelectTime int `json:"secret_price_select_time,omitempty"`
+	RoundEndTime          int `json:"round_end_time,omitempty"`
+	FinalStakeTime        int `json:"final_stake_time,omitempty"`
+	FinalAnswerTime       int `json:"final_answer_time,omitempty"`
 }
 
 func DefaultSettings() Settings {
 	return Settings{
-		TimeForAnswer: 30,
-		TimeForChoice: 20,
+		TimeForAnswer:  30,
+		TimeForChoice:  20,
+		AnswerMatch:    DefaultAnswerMatch,
+		MatchThreshold: DefaultMatchThreshold,
+	}
+}
+
+func (s Settings) GetAnswerMatch() AnswerMatchStrategy {
+	if s.AnswerMatch == "" {
+		return DefaultAnswerMatch
 	}
+	return s.AnswerMatch
+}
+
+func (s Settings) GetMatchThreshold() float64 {
+	if s.MatchThreshold == 0 {
+		return DefaultMatchThreshold
+	}
+	return s.MatchThreshold
+}
+
+func (s Settings) GetFalseStartLockout() time.Duration {
+	return time.Duration(s.FalseStartLockoutMs) * time.Millisecond
+}
+
+func (s Settings) GetReadingSpeed() int {
+	if s.ReadingSpeed == 0 {
+		return DefaultReadingSpeed
+	}
+	return s.ReadingSpeed
+}
+
+func (s Settings) GetSelectionPolicy() SelectionPolicy {
+	if s.SelectionPolicy == "" {
+		return DefaultSelectionPolicy
+	}
+	return s.SelectionPolicy
+}
+
+func (s Settings) GetAnswerRevealDuration() time.Duration {
+	if s.AnswerRevealMs == 0 {
+		return DefaultAnswerRevealMs * time.Millisecond
+	}
+	return time.Duration(s.AnswerRevealMs) * time.Millisecond
 }
 
 func (s Settings) Validate() error {
@@ -19,6 +140,26 @@ func (s Settings) Validate() error {
 	if s.TimeForChoice <= 0 || s.TimeForChoice > 300 {
 		return ErrInvalidSettings
 	}
-	return nil
+	if !s.GetAnswerMatch().IsValid() {
+		return ErrInvalidSettings
+	}
+	if s.MatchThreshold < 0 || s.MatchThreshold > 1 {
+		return ErrInvalidSettings
+	}
+	if s.FalseStartLockoutMs < 0 || s.FalseStartLockoutMs > MaxFalseStartLockoutMs {
+		return ErrInvalidSettings
+	}
+	if s.ReadingSpeed < 0 || s.ReadingSpeed > MaxReadingSpeed {
+		return ErrInvalidSettings
+	}
+	if !s.GetSelectionPolicy().IsValid() {
+		return ErrInvalidSettings
+	}
+	if s.AnswerRevealMs < 0 || s.AnswerRevealMs > MaxAnswerRevealMs {
+		return ErrInvalidSettings
+	}
+	if err := s.validateTimings(); err != nil {
+		return err
+	}
+	return s.Scoring.Validate()
 }
-
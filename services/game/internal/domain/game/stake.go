@@ -0,0 +1,164 @@
+package game
+
+import "github.com/google/uuid"
+
+type StakeBidStatus string
+
+const (
+	StakeBidWaiting StakeBidStatus = "waiting"
+	StakeBidPlaced  StakeBidStatus = "bid"
+	StakeBidPassed  StakeBidStatus = "passed"
+	StakeBidAllIn   StakeBidStatus = "all_in"
+)
+
+type StakeBid struct {
+	UserID uuid.UUID      `json:"userId"`
+	Status StakeBidStatus `json:"status"`
+	Amount int            `json:"amount"`
+	Limit  int            `json:"limit"`
+}
+
+func NewStakeAuction(order []uuid.UUID, limits map[uuid.UUID]int, minBet, maxBet int) *StakeInfo {
+	s := &StakeInfo{
+		MinBet: minBet,
+		MaxBet: maxBet,
+		Bids:   make([]StakeBid, 0, len(order)),
+		turn:   -1,
+	}
+	for _, userID := range order {
+		s.Bids = append(s.Bids, StakeBid{UserID: userID, Status: StakeBidWaiting, Limit: limits[userID]})
+	}
+	s.advance()
+	return s
+}
+
+func (s *StakeInfo) TurnPlayer() (uuid.UUID, bool) {
+	if s.CurrentTurn == nil {
+		return uuid.Nil, false
+	}
+	return *s.CurrentTurn, true
+}
+
+func (s *StakeInfo) IsFinished() bool {
+	return s.CurrentTurn == nil
+}
+
+func (s *StakeInfo) MinimumBid() int {
+	if s.Leader == nil {
+		return s.MinBet
+	}
+	return s.CurrentBet + 1
+}
+
+func (s *StakeInfo) PlaceBid(userID uuid.UUID, amount int) error {
+	bid, err := s.turnBid(userID)
+	if err != nil {
+		return err
+	}
+	if s.IsAllIn || amount < s.MinimumBid() {
+		return ErrStakeTooLow
+	}
+	if amount > bid.Limit {
+		return ErrStakeTooHigh
+	}
+	if amount == bid.Limit {
+		return s.AllIn(userID)
+	}
+
+	bid.Status = StakeBidPlaced
+	bid.Amount = amount
+	s.lead(bid)
+	s.advance()
+	return nil
+}
+
+func (s *StakeInfo) AllIn(userID uuid.UUID) error {
+	bid, err := s.turnBid(userID)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case s.IsAllIn && bid.Limit == s.CurrentBet:
+		bid.Status = StakeBidAllIn
+		bid.Amount = bid.Limit
+		s.AllInTies = append(s.AllInTies, userID)
+	case bid.Limit >= s.MinimumBid():
+		bid.Status = StakeBidAllIn
+		bid.Amount = bid.Limit
+		s.IsAllIn = true
+		s.AllInTies = nil
+		s.lead(bid)
+	default:
+		return ErrStakeTooLow
+	}
+
+	s.advance()
+	return nil
+}
+
+func (s *StakeInfo) Pass(userID uuid.UUID) error {
+	bid, err := s.turnBid(userID)
+	if err != nil {
+		return err
+	}
+
+	bid.Status = StakeBidPassed
+	s.advance()
+	return nil
+}
+
+func (s *StakeInfo) ForceWinner() uuid.UUID {
+	if s.Leader != nil {
+		return *s.Leader
+	}
+	if len(s.Bids) == 0 {
+		return uuid.Nil
+	}
+
+	bid := &s.Bids[0]
+	bid.Status = StakeBidPlaced
+	bid.Amount = s.MinBet
+	s.lead(bid)
+	s.CurrentTurn = nil
+	return bid.UserID
+}
+
+func (s *StakeInfo) turnBid(userID uuid.UUID) (*StakeBid, error) {
+	if s.CurrentTurn == nil || *s.CurrentTurn != userID {
+		return nil, ErrNotStakeTurn
+	}
+	return &s.Bids[s.turn], nil
+}
+
+func (s *StakeInfo) lead(bid *StakeBid) {
+	leader := bid.UserID
+	s.Leader = &leader
+	s.CurrentBet = bid.Amount
+}
+
+func (s *StakeInfo) canAct(bid StakeBid) bool {
+	if bid.Status == StakeBidPassed || bid.Status == StakeBidAllIn {
+		return false
+	}
+	if s.Leader != nil && *s.Leader == bid.UserID {
+		return false
+	}
+	if s.IsAllIn {
+		return bid.Limit >= s.CurrentBet
+	}
+	return bid.Limit >= s.MinimumBid()
+}
+
+func (s *StakeInfo) advance() {
+	s.CurrentTurn = nil
+	for i := 1; i <= len(s.Bids); i++ {
+		next := (s.turn + i + len(s.Bids)) % len(s.Bids)
+		if s.canAct(s.Bids[next]) {
+			s.turn = next
+			userID := s.Bids[next].UserID
+			s.CurrentTurn = &userID
+			return
+		}
+	}
+}
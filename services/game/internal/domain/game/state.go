@@ -9,10 +9,12 @@ import (
 type State struct {
 	GameID          uuid.UUID           `json:"gameId" binding:"required"`
 	Status          Status              `json:"status" binding:"required"`
+	Paused          bool                `json:"paused"`
 	CurrentRound    int                 `json:"currentRound" binding:"required"`
 	RoundName       string              `json:"roundName,omitempty"`
 	Themes          []pack.ThemeState   `json:"themes,omitempty"`
 	Players         []player.State      `json:"players" binding:"required"`
+	Spectators      []player.State      `json:"spectators,omitempty"`
 	ActivePlayer    *uuid.UUID          `json:"activePlayer,omitempty"`
 	CurrentQuestion *pack.QuestionState `json:"currentQuestion,omitempty"`
 	TimeRemaining   int                 `json:"timeRemaining,omitempty"`
@@ -23,7 +25,12 @@ type State struct {
 
 	StakeInfo     *StakeInfo         `json:"stakeInfo,omitempty"`
 	SecretTarget  *uuid.UUID         `json:"secretTarget,omitempty"`
+	SecretInfo    *SecretInfo        `json:"secretInfo,omitempty"`
 	ForAllResults []ForAllResult     `json:"forAllResults,omitempty"`
+	Final         *FinalState        `json:"final,omitempty"`
+	AnswerMatch   *AnswerMatch       `json:"answerMatch,omitempty"`
+	LockedOut     []uuid.UUID        `json:"lockedOut,omitempty"`
+	AnswerReveal  *AnswerReveal      `json:"answerReveal,omitempty"`
 }
 
 type RoundOverview struct {
@@ -33,10 +40,24 @@ type RoundOverview struct {
 }
 
 type StakeInfo struct {
-	MinBet     int  `json:"minBet"`
-	MaxBet     int  `json:"maxBet"`
-	CurrentBet int  `json:"currentBet"`
-	IsAllIn    bool `json:"isAllIn"`
+	MinBet      int         `json:"minBet"`
+	MaxBet      int         `json:"maxBet"`
+	CurrentBet  int         `json:"currentBet"`
+	IsAllIn     bool        `json:"isAllIn"`
+	Bids        []StakeBid  `json:"bids,omitempty"`
+	CurrentTurn *uuid.UUID  `json:"currentTurn,omitempty"`
+	Leader      *uuid.UUID  `json:"leader,omitempty"`
+	AllInTies   []uuid.UUID `json:"allInTies,omitempty"`
+	turn        int
+}
+
+type SecretInfo struct {
+	Theme         string      `json:"theme"`
+	ChooserID     uuid.UUID   `json:"chooserId"`
+	SelectionMode string      `json:"selectionMode"`
+	Candidates    []uuid.UUID `json:"candidates"`
+	AllowedPrices []int       `json:"allowedPrices"`
+	Price         int         `json:"price,omitempty"`
 }
 
 type ForAllResult struct {
@@ -45,5 +66,50 @@ type ForAllResult struct {
 	Answer     string    `json:"answer"`
 	IsCorrect  bool      `json:"isCorrect"`
 	ScoreDelta int       `json:"scoreDelta"`
+	Confidence float64   `json:"confidence,omitempty"`
+}
+
+type AnswerMatch struct {
+	UserID     uuid.UUID `json:"userId"`
+	Answer     string    `json:"answer"`
+	Matched    bool      `json:"matched"`
+	Confidence float64   `json:"confidence"`
+	Borderline bool      `json:"borderline"`
+}
+
+type AnswerReveal struct {
+	QuestionID   string        `json:"questionId"`
+	Answer       string        `json:"answer"`
+	AltAnswers   []string      `json:"altAnswers,omitempty"`
+	Comment      string        `json:"comment,omitempty"`
+	MediaType    string        `json:"mediaType,omitempty"`
+	MediaURL     string        `json:"mediaUrl,omitempty"`
+	ScoreChanges []ScoreChange `json:"scoreChanges"`
 }
 
+type ScoreChange struct {
+	UserID   uuid.UUID `json:"userId"`
+	Username string    `json:"username"`
+	Delta    int       `json:"delta"`
+	Score    int       `json:"score"`
+}
+
+type FinalState struct {
+	Participants     []uuid.UUID       `json:"participants"`
+	RemovedThemes    []string          `json:"removedThemes"`
+	TurnPlayer       *uuid.UUID        `json:"turnPlayer,omitempty"`
+	StakesPlaced     []uuid.UUID       `json:"stakesPlaced"`
+	AnswersSubmitted []uuid.UUID       `json:"answersSubmitted"`
+	Stakes           map[uuid.UUID]int `json:"stakes,omitempty"`
+	Answers          []FinalAnswer     `json:"answers,omitempty"`
+}
+
+type FinalAnswer struct {
+	UserID     uuid.UUID `json:"userId"`
+	Username   string    `json:"username"`
+	Answer     string    `json:"answer"`
+	Stake      int       `json:"stake"`
+	Judged     bool      `json:"judged"`
+	Correct    bool      `json:"correct"`
+	Confidence float64   `json:"confidence,omitempty"`
+}
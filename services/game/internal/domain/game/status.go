@@ -19,6 +19,15 @@ const (
 	StatusStakeBetting   Status = "stake_betting"
 	StatusForAllAnswering Status = "for_all_answering"
 	StatusForAllResults   Status = "for_all_results"
+
+	StatusSecretPriceSelect Status = "secret_price_select"
+
+	StatusAnswerReveal Status = "answer_reveal"
+
+	StatusFinalThemeSelect Status = "final_theme_select"
+	StatusFinalStake       Status = "final_stake"
+	StatusFinalAnswering   Status = "final_answering"
+	StatusFinalJudging     Status = "final_judging"
 )
 
 func (s Status) String() string {
@@ -33,3 +42,25 @@ func (s Status) IsPlaying() bool {
 	return s != StatusWaiting && s != StatusFinished && s != StatusCancelled
 }
 
+
+func (s Status) ShowsQuestion() bool {
+	switch s {
+	case StatusQuestionShow, StatusButtonPress, StatusAnswering, StatusAnswerJudging,
+		StatusForAllAnswering, StatusForAllResults, StatusAnswerReveal,
+		StatusFinalAnswering, StatusFinalJudging:
+		return true
+	}
+	return false
+}
+
+func (s Status) RevealsAnswer() bool {
+	return s == StatusForAllResults || s == StatusAnswerReveal || s == StatusFinalJudging
+}
+
+func (s Status) IsFinalRound() bool {
+	switch s {
+	case StatusFinalThemeSelect, StatusFinalStake, StatusFinalAnswering, StatusFinalJudging:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,106 @@
+package game
+
+import "time"
+
+const (
+	DefaultRoundsOverviewTime = 5
+	DefaultRoundIntroTime     = 3
+	DefaultJudgingTime        = 30
+	DefaultSecretTransferTime = 30
+	DefaultStakeBettingTime   = 20
+	DefaultButtonWindowMs     = 150
+	DefaultResultsDisplayTime = 5
+
+	DefaultSecretPriceSelectTime = 15
+	DefaultRoundEndTime          = 5
+	DefaultFinalStakeTime        = 30
+	DefaultFinalAnswerTime       = 60
+
+	MinDisplayTime    = 1
+	MaxDisplayTime    = 60
+	MinTurnTime       = 5
+	MaxTurnTime       = 300
+	MaxButtonWindowMs = 1000
+)
+
+func (s Settings) GetRoundsOverviewDuration() time.Duration {
+	return secondsOrDefault(s.RoundsOverviewTime, DefaultRoundsOverviewTime)
+}
+
+func (s Settings) GetRoundIntroDuration() time.Duration {
+	return secondsOrDefault(s.RoundIntroTime, DefaultRoundIntroTime)
+}
+
+func (s Settings) GetJudgingDuration() time.Duration {
+	return secondsOrDefault(s.JudgingTime, DefaultJudgingTime)
+}
+
+func (s Settings) GetSecretTransferDuration() time.Duration {
+	return secondsOrDefault(s.SecretTransferTime, DefaultSecretTransferTime)
+}
+
+func (s Settings) GetStakeBettingDuration() time.Duration {
+	return secondsOrDefault(s.StakeBettingTime, DefaultStakeBettingTime)
+}
+
+func (s Settings) GetButtonWindow() time.Duration {
+	if s.ButtonWindowMs == 0 {
+		return DefaultButtonWindowMs * time.Millisecond
+	}
+	return time.Duration(s.ButtonWindowMs) * time.Millisecond
+}
+
+func (s Settings) GetResultsDisplayDuration() time.Duration {
+	return secondsOrDefault(s.ResultsDisplayTime, DefaultResultsDisplayTime)
+}
+
+func (s Settings) GetSecretPriceSelectDuration() time.Duration {
+	return secondsOrDefault(s.SecretPriceSelectTime, DefaultSecretPriceSelectTime)
+}
+
+func (s Settings) GetRoundEndDuration() time.Duration {
+	return secondsOrDefault(s.RoundEndTime, DefaultRoundEndTime)
+}
+
+func (s Settings) GetFinalStakeDuration() time.Duration {
+	return secondsOrDefault(s.FinalStakeTime, DefaultFinalStakeTime)
+}
+
+func (s Settings) GetFinalAnswerDuration() time.Duration {
+	return secondsOrDefault(s.FinalAnswerTime, DefaultFinalAnswerTime)
+}
+
+func (s Settings) validateTimings() error {
+	displays := []int{s.RoundsOverviewTime, s.RoundIntroTime, s.ResultsDisplayTime, s.RoundEndTime}
+	for _, seconds := range displays {
+		if !inRangeOrUnset(seconds, MinDisplayTime, MaxDisplayTime) {
+			return ErrInvalidSettings
+		}
+	}
+
+	turns := []int{
+		s.JudgingTime, s.SecretTransferTime, s.StakeBettingTime,
+		s.SecretPriceSelectTime, s.FinalStakeTime, s.FinalAnswerTime,
+	}
+	for _, seconds := range turns {
+		if !inRangeOrUnset(seconds, MinTurnTime, MaxTurnTime) {
+			return ErrInvalidSettings
+		}
+	}
+
+	if s.ButtonWindowMs < 0 || s.ButtonWindowMs > MaxButtonWindowMs {
+		return ErrInvalidSettings
+	}
+	return nil
+}
+
+func secondsOrDefault(seconds, defaultSeconds int) time.Duration {
+	if seconds == 0 {
+		seconds = defaultSeconds
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+func inRangeOrUnset(value, min, max int) bool {
+	return value == 0 || (value >= min && value <= max)
+}
@@ -0,0 +1,244 @@
+package game
+
+import (
+	"fmt"
+	"strings"
+)
+
+type Transition struct {
+	From    Status
+	To      Status
+	Trigger string
+	Guard   func(g *Game) error
+	Enter   func(g *Game)
+}
+
+type StateMachine struct {
+	transitions []Transition
+	index       map[Status]map[Status]int
+}
+
+var questionPhases = []Status{
+	StatusQuestionShow,
+	StatusButtonPress,
+	StatusAnswering,
+	StatusAnswerJudging,
+	StatusSecretTransfer,
+	StatusSecretPriceSelect,
+	StatusStakeBetting,
+	StatusForAllAnswering,
+	StatusForAllResults,
+	StatusAnswerReveal,
+}
+
+var finalPhases = []Status{
+	StatusFinalThemeSelect,
+	StatusFinalStake,
+	StatusFinalAnswering,
+	StatusFinalJudging,
+}
+
+var Machine = NewStateMachine(gameTransitions())
+
+func gameTransitions() []Transition {
+	transitions := []Transition{
+		{From: StatusWaiting, To: StatusRoundsOverview, Trigger: "game started"},
+		{From: StatusRoundsOverview, To: StatusRoundStart, Trigger: "timer", Guard: requireRound, Enter: clearTurn},
+		{From: StatusRoundsOverview, To: StatusGameEnd, Trigger: "no rounds", Enter: clearTurn},
+
+		{From: StatusRoundStart, To: StatusQuestionSelect, Trigger: "timer", Guard: requireHost, Enter: clearQuestion},
+		{From: StatusRoundStart, To: StatusFinalThemeSelect, Trigger: "final round", Guard: requireFinal},
+		{From: StatusRoundStart, To: StatusFinalStake, Trigger: "single final theme", Guard: requireFinal},
+		{From: StatusRoundStart, To: StatusRoundEnd, Trigger: "END_ROUND", Enter: clearQuestion},
+		{From: StatusRoundStart, To: StatusGameEnd, Trigger: "no final participants", Enter: clearTurn},
+
+		{From: StatusQuestionSelect, To: StatusQuestionShow, Trigger: "SELECT_QUESTION", Guard: requireQuestion},
+		{From: StatusQuestionSelect, To: StatusSecretTransfer, Trigger: "secret question", Guard: requireQuestion},
+		{From: StatusQuestionSelect, To: StatusStakeBetting, Trigger: "stake question", Guard: requireQuestion},
+		{From: StatusQuestionSelect, To: StatusRoundEnd, Trigger: "round complete", Enter: clearQuestion},
+
+		{From: StatusQuestionShow, To: StatusButtonPress, Trigger: "timer", Guard: requireQuestion},
+		{From: StatusQuestionShow, To: StatusAnswerJudging, Trigger: "timer (secret, stake)", Guard: requireAnswerer},
+		{From: StatusQuestionShow, To: StatusForAllAnswering, Trigger: "timer (for all)", Guard: requireQuestion},
+
+		{From: StatusButtonPress, To: StatusAnswerJudging, Trigger: "PRESS_BUTTON", Guard: requireAnswerer},
+		{From: StatusButtonPress, To: StatusAnswering, Trigger: "timer with presses", Guard: requireAnswerer},
+
+		{From: StatusAnswering, To: StatusAnswerJudging, Trigger: "SUBMIT_ANSWER", Guard: requireAnswerer},
+		{From: StatusAnswerJudging, To: StatusButtonPress, Trigger: "wrong answer, buttons reopened", Guard: requireQuestion},
+
+		{From: StatusSecretTransfer, To: StatusSecretPriceSelect, Trigger: "TRANSFER_SECRET", Guard: requireAnswerer},
+		{From: StatusSecretTransfer, To: StatusQuestionShow, Trigger: "TRANSFER_SECRET", Guard: requireAnswerer},
+		{From: StatusSecretPriceSelect, To: StatusQuestionShow, Trigger: "SELECT_SECRET_PRICE", Guard: requireAnswerer},
+
+		{From: StatusStakeBetting, To: StatusQuestionShow, Trigger: "auction finished", Guard: requireAnswerer},
+
+		{From: StatusForAllAnswering, To: StatusForAllResults, Trigger: "all answered"},
+	}
+
+	for _, phase := range questionPhases {
+		if phase != StatusAnswerReveal {
+			transitions = append(transitions,
+				Transition{From: phase, To: StatusAnswerReveal, Trigger: "question finished", Guard: requireQuestion},
+			)
+		}
+		transitions = append(transitions,
+			Transition{From: phase, To: StatusQuestionSelect, Trigger: "next question", Guard: requireHost, Enter: clearQuestion},
+			Transition{From: phase, To: StatusRoundEnd, Trigger: "round complete", Enter: clearQuestion},
+		)
+	}
+
+	transitions = append(transitions,
+		Transition{From: StatusFinalThemeSelect, To: StatusFinalStake, Trigger: "theme chosen", Guard: requireFinal},
+		Transition{From: StatusFinalStake, To: StatusFinalAnswering, Trigger: "stakes placed", Guard: requireFinal},
+		Transition{From: StatusFinalAnswering, To: StatusFinalJudging, Trigger: "answers submitted", Guard: requireFinal},
+	)
+	for _, phase := range finalPhases {
+		transitions = append(transitions,
+			Transition{From: phase, To: StatusRoundEnd, Trigger: "final finished", Enter: clearQuestion},
+		)
+	}
+
+	transitions = append(transitions,
+		Transition{From: StatusRoundEnd, To: StatusRoundStart, Trigger: "timer", Guard: requireRound, Enter: clearTurn},
+		Transition{From: StatusRoundEnd, To: StatusGameEnd, Trigger: "last round", Enter: clearTurn},
+		Transition{From: StatusGameEnd, To: StatusFinished, Trigger: "game finished"},
+	)
+
+	return transitions
+}
+
+func NewStateMachine(transitions []Transition) *StateMachine {
+	sm := &StateMachine{
+		transitions: transitions,
+		index:       make(map[Status]map[Status]int),
+	}
+	for i, t := range transitions {
+		if sm.index[t.From] == nil {
+			sm.index[t.From] = make(map[Status]int)
+		}
+		if _, exists := sm.index[t.From][t.To]; !exists {
+			sm.index[t.From][t.To] = i
+		}
+	}
+	return sm
+}
+
+func (sm *StateMachine) Transitions() []Transition {
+	return sm.transitions
+}
+
+func (sm *StateMachine) Apply(g *Game, to Status) error {
+	from := g.Status
+	if from == to {
+		return nil
+	}
+
+	i, ok := sm.index[from][to]
+	if !ok {
+		return fmt.Errorf("%w: %s -> %s", ErrIllegalTransition, from, to)
+	}
+
+	t := sm.transitions[i]
+	if t.Guard != nil {
+		if err := t.Guard(g); err != nil {
+			return fmt.Errorf("%w: %s -> %s: %v", ErrTransitionGuard, from, to, err)
+		}
+	}
+
+	g.UpdateStatus(to)
+	if t.Enter != nil {
+		t.Enter(g)
+	}
+	return nil
+}
+
+func (sm *StateMachine) Mermaid() string {
+	var b strings.Builder
+	b.WriteString("stateDiagram-v2\n")
+	fmt.Fprintf(&b, "    [*] --> %s\n", StatusWaiting)
+	for _, edge := range sm.edges() {
+		fmt.Fprintf(&b, "    %s --> %s: %s\n", edge.from, edge.to, edge.label)
+	}
+	fmt.Fprintf(&b, "    %s --> [*]\n", StatusFinished)
+	return b.String()
+}
+
+func (sm *StateMachine) Graphviz() string {
+	var b strings.Builder
+	b.WriteString("digraph game {\n")
+	b.WriteString("    rankdir=LR;\n")
+	for _, edge := range sm.edges() {
+		fmt.Fprintf(&b, "    %q -> %q [label=%q];\n", edge.from, edge.to, edge.label)
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+type diagramEdge struct {
+	from  Status
+	to    Status
+	label string
+}
+
+func (sm *StateMachine) edges() []diagramEdge {
+	edges := make([]diagramEdge, 0, len(sm.transitions))
+	seen := make(map[[2]Status]int)
+	for _, t := range sm.transitions {
+		key := [2]Status{t.From, t.To}
+		if i, ok := seen[key]; ok {
+			if !strings.Contains(edges[i].label, t.Trigger) {
+				edges[i].label += " / " + t.Trigger
+			}
+			continue
+		}
+		seen[key] = len(edges)
+		edges = append(edges, diagramEdge{from: t.From, to: t.To, label: t.Trigger})
+	}
+	return edges
+}
+
+func requireRound(g *Game) error {
+	if g.CurrentRound <= 0 {
+		return ErrInvalidRound
+	}
+	return nil
+}
+
+func requireHost(g *Game) error {
+	_, err := g.GetHost()
+	return err
+}
+
+func requireQuestion(g *Game) error {
+	if g.CurrentQuestion == nil {
+		return ErrNoCurrentQuestion
+	}
+	return nil
+}
+
+func requireAnswerer(g *Game) error {
+	if err := requireQuestion(g); err != nil {
+		return err
+	}
+	if g.ActivePlayer == nil {
+		return ErrNoActivePlayer
+	}
+	return nil
+}
+
+func requireFinal(g *Game) error {
+	if g.Final == nil {
+		return ErrFinalNotStarted
+	}
+	return nil
+}
+
+func clearQuestion(g *Game) {
+	g.ClearCurrentQuestion()
+}
+
+func clearTurn(g *Game) {
+	g.ClearCurrentQuestion()
+	g.ClearActivePlayer()
+}
@@ -0,0 +1,129 @@
+package game
+
+import (
+	"github.com/google/uuid"
+	"sigame/game/internal/domain/pack"
+	"sigame/game/internal/domain/player"
+)
+
+type Audience string
+
+const (
+	AudienceHost      Audience = "host"
+	AudiencePlayer    Audience = "player"
+	AudienceSpectator Audience = "spectator"
+)
+
+func (a Audience) String() string {
+	return string(a)
+}
+
+func (s *State) ForAudience(audience Audience) *State {
+	if audience == AudienceHost {
+		return s
+	}
+
+	projected := *s
+	projected.Players = hidePlayerRTT(s.Players)
+	projected.Spectators = hidePlayerRTT(s.Spectators)
+
+	if s.Themes != nil {
+		projected.Themes = make([]pack.ThemeState, len(s.Themes))
+		for i, theme := range s.Themes {
+			projected.Themes[i] = hideThemeQuestions(theme)
+		}
+	}
+
+	if s.CurrentQuestion != nil {
+		question := *s.CurrentQuestion
+		if !s.Status.RevealsAnswer() {
+			question.Answer = ""
+			question.AltAnswers = nil
+		}
+		if !s.Status.ShowsQuestion() {
+			hideQuestionContent(&question)
+		}
+		projected.CurrentQuestion = &question
+	}
+
+	if s.Status == StatusSecretTransfer {
+		projected.SecretTarget = nil
+	}
+
+	if s.StakeInfo != nil && audience == AudienceSpectator {
+		projected.StakeInfo = &StakeInfo{
+			CurrentBet:  s.StakeInfo.CurrentBet,
+			IsAllIn:     s.StakeInfo.IsAllIn,
+			Bids:        s.StakeInfo.Bids,
+			CurrentTurn: s.StakeInfo.CurrentTurn,
+			Leader:      s.StakeInfo.Leader,
+			AllInTies:   s.StakeInfo.AllInTies,
+		}
+	}
+
+	projected.AnswerMatch = nil
+
+	if s.ForAllResults != nil {
+		projected.ForAllResults = make([]ForAllResult, len(s.ForAllResults))
+		for i, result := range s.ForAllResults {
+			result.Confidence = 0
+			projected.ForAllResults[i] = result
+		}
+	}
+
+	if s.Final != nil {
+		projected.Final = hideFinalSecrets(s.Final, s.Status, s.ActivePlayer)
+	}
+
+	return &projected
+}
+
+func hidePlayerRTT(players []player.State) []player.State {
+	if players == nil {
+		return nil
+	}
+
+	hidden := make([]player.State, len(players))
+	for i, p := range players {
+		p.RTTMs = 0
+		hidden[i] = p
+	}
+	return hidden
+}
+
+func hideFinalSecrets(final *FinalState, status Status, activePlayer *uuid.UUID) *FinalState {
+	hidden := *final
+	hidden.Stakes = nil
+	hidden.Answers = make([]FinalAnswer, 0, len(final.Answers))
+	for _, answer := range final.Answers {
+		answer.Confidence = 0
+		if answer.Judged {
+			hidden.Answers = append(hidden.Answers, answer)
+			continue
+		}
+		if status == StatusFinalJudging && activePlayer != nil && *activePlayer == answer.UserID {
+			answer.Stake = 0
+			hidden.Answers = append(hidden.Answers, answer)
+		}
+	}
+	return &hidden
+}
+
+func hideThemeQuestions(theme pack.ThemeState) pack.ThemeState {
+	questions := make([]pack.QuestionState, len(theme.Questions))
+	for i, q := range theme.Questions {
+		q.Answer = ""
+		q.AltAnswers = nil
+		hideQuestionContent(&q)
+		questions[i] = q
+	}
+	theme.Questions = questions
+	return theme
+}
+
+func hideQuestionContent(q *pack.QuestionState) {
+	q.Text = ""
+	q.MediaType = ""
+	q.MediaURL = ""
+	q.MediaDurationMs = 0
+}
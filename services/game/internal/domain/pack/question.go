@@ -1,14 +1,13 @@
 package pack
 
-import "strings"
-
 type Type string
 
 const (
-	TypeNormal Type = "normal"
-	TypeSecret Type = "secret"
-	TypeStake  Type = "stake"
-	TypeForAll Type = "forAll"
+	TypeStandard Type = "standard"
+	TypeNormal   Type = "normal"
+	TypeSecret   Type = "secret"
+	TypeStake    Type = "stake"
+	TypeForAll   Type = "forAll"
 )
 
 func (t Type) String() string {
@@ -24,10 +23,16 @@ type Question struct {
 	Price           int
 	Text            string
 	Answer          string
+	AltAnswers      []string
 	Type            Type
 	MediaType       string
 	MediaURL        string
 	MediaDurationMs int
+	Comment         string
+	AnswerMediaType string
+	AnswerMediaURL  string
+	SecretParams    *SecretParams
+	StakeParams     *StakeParams
 	Used            bool
 }
 
@@ -40,11 +45,12 @@ type QuestionState struct {
 	MediaType       string `json:"mediaType,omitempty"`
 	MediaURL        string `json:"mediaUrl,omitempty"`
 	MediaDurationMs int    `json:"mediaDurationMs,omitempty"`
-	Answer          string `json:"answer,omitempty"`
+	Answer          string   `json:"answer,omitempty"`
+	AltAnswers      []string `json:"altAnswers,omitempty"`
 }
 
 func (q *Question) GetType() Type {
-	if q.Type == "" {
+	if q.Type == "" || q.Type == TypeStandard {
 		return TypeNormal
 	}
 	return q.Type
@@ -54,10 +60,10 @@ func (q *Question) IsSpecialType() bool {
 	return q.GetType().IsSpecial()
 }
 
-func (q *Question) ValidateAnswer(userAnswer string) bool {
-	normalized := strings.TrimSpace(strings.ToLower(userAnswer))
-	correctAnswer := strings.TrimSpace(strings.ToLower(q.Answer))
-	return normalized == correctAnswer
+func (q *Question) AcceptedAnswers() []string {
+	answers := make([]string, 0, len(q.AltAnswers)+1)
+	answers = append(answers, q.Answer)
+	return append(answers, q.AltAnswers...)
 }
 
 func (q *Question) MarkAsUsed() {
@@ -89,6 +95,7 @@ func (q *Question) ToState(includeText bool) QuestionState {
 func (q *Question) ToStateWithAnswer(includeText bool) QuestionState {
 	state := q.ToState(includeText)
 	state.Answer = q.Answer
+	state.AltAnswers = q.AltAnswers
 	return state
 }
 
@@ -96,3 +103,7 @@ func (q *Question) HasMedia() bool {
 	return q.MediaType != "" && q.MediaType != "text" && q.MediaURL != ""
 }
 
+func (q *Question) HasAnswerMedia() bool {
+	return q.AnswerMediaType != "" && q.AnswerMediaType != "text" && q.AnswerMediaURL != ""
+}
+
@@ -1,12 +1,44 @@
 package pack
 
+type RoundType string
+
+const (
+	RoundTypeNormal RoundType = "normal"
+	RoundTypeFinal  RoundType = "final"
+)
+
+func (t RoundType) String() string {
+	return string(t)
+}
+
 type Round struct {
 	ID          string
 	RoundNumber int
 	Name        string
+	Type        RoundType
 	Themes      []*Theme
 }
 
+func (r *Round) Clone() *Round {
+	clone := *r
+	clone.Themes = make([]*Theme, len(r.Themes))
+	for i, theme := range r.Themes {
+		clone.Themes[i] = theme.Clone()
+	}
+	return &clone
+}
+
+func (r *Round) GetType() RoundType {
+	if r.Type == "" {
+		return RoundTypeNormal
+	}
+	return r.Type
+}
+
+func (r *Round) IsFinal() bool {
+	return r.GetType() == RoundTypeFinal
+}
+
 func (r *Round) GetAvailableThemes() []*Theme {
 	available := make([]*Theme, 0)
 	for _, theme := range r.Themes {
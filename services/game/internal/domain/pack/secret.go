@@ -0,0 +1,64 @@
+package pack
+
+type SecretSelectionMode string
+
+const (
+	SecretSelectionAny           SecretSelectionMode = "any"
+	SecretSelectionExceptCurrent SecretSelectionMode = "exceptCurrent"
+)
+
+func (m SecretSelectionMode) String() string {
+	return string(m)
+}
+
+type SecretParams struct {
+	SelectionMode SecretSelectionMode
+	Theme         string
+	MinPrice      int
+	MaxPrice      int
+	PriceStep     int
+}
+
+func (p *SecretParams) GetSelectionMode() SecretSelectionMode {
+	if p == nil || p.SelectionMode == "" {
+		return SecretSelectionAny
+	}
+	return p.SelectionMode
+}
+
+func (p *SecretParams) GetTheme(fallback string) string {
+	if p == nil || p.Theme == "" {
+		return fallback
+	}
+	return p.Theme
+}
+
+func (p *SecretParams) HasPriceChoice() bool {
+	return p != nil && p.MinPrice > 0 && p.MaxPrice > p.MinPrice
+}
+
+func (p *SecretParams) AllowedPrices(boardPrice int) []int {
+	if !p.HasPriceChoice() {
+		return []int{boardPrice}
+	}
+
+	step := p.PriceStep
+	if step <= 0 {
+		step = p.MaxPrice - p.MinPrice
+	}
+
+	prices := make([]int, 0)
+	for price := p.MinPrice; price < p.MaxPrice; price += step {
+		prices = append(prices, price)
+	}
+	return append(prices, p.MaxPrice)
+}
+
+func (p *SecretParams) IsAllowedPrice(boardPrice, price int) bool {
+	for _, allowed := range p.AllowedPrices(boardPrice) {
+		if allowed == price {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,20 @@
+package pack
+
+type StakeParams struct {
+	MinStake int
+	MaxStake int
+}
+
+func (p *StakeParams) GetMinStake(boardPrice int) int {
+	if p == nil || p.MinStake <= 0 {
+		return boardPrice
+	}
+	return p.MinStake
+}
+
+func (p *StakeParams) GetMaxStake() int {
+	if p == nil || p.MaxStake <= 0 {
+		return 0
+	}
+	return p.MaxStake
+}
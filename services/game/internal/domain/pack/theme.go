@@ -11,6 +11,16 @@ type ThemeState struct {
 	Questions []QuestionState `json:"questions" binding:"required"`
 }
 
+func (t *Theme) Clone() *Theme {
+	clone := *t
+	clone.Questions = make([]*Question, len(t.Questions))
+	for i, q := range t.Questions {
+		question := *q
+		clone.Questions[i] = &question
+	}
+	return &clone
+}
+
 func (t *Theme) GetAvailableQuestions() []*Question {
 	available := make([]*Question, 0)
 	for _, q := range t.Questions {
@@ -15,6 +15,9 @@ type Player struct {
 	IsActive    bool
 	IsReady     bool
 	IsConnected bool
+	RTT         time.Duration
+	ClockOffset time.Duration
+	ClockSynced bool
 	JoinedAt    time.Time
 	LeftAt      *time.Time
 }
@@ -28,6 +31,7 @@ type State struct {
 	IsActive    bool      `json:"isActive" binding:"required"`
 	IsReady     bool      `json:"isReady" binding:"required"`
 	IsConnected bool      `json:"isConnected" binding:"required"`
+	RTTMs       int64     `json:"rttMs,omitempty"`
 }
 
 func New(userID uuid.UUID, username string, avatarURL string, role Role) *Player {
@@ -54,6 +58,7 @@ func (p *Player) ToState() State {
 		IsActive:    p.IsActive,
 		IsReady:     p.IsReady,
 		IsConnected: p.IsConnected,
+		RTTMs:       p.RTT.Milliseconds(),
 	}
 }
 
@@ -61,6 +66,15 @@ func (p *Player) SetConnected(connected bool) {
 	p.IsConnected = connected
 }
 
+func (p *Player) SetRTT(rtt time.Duration) {
+	p.RTT = rtt
+}
+
+func (p *Player) SetClockOffset(offset time.Duration) {
+	p.ClockOffset = offset
+	p.ClockSynced = true
+}
+
 func (p *Player) AddScore(points int) {
 	p.Score += points
 }
@@ -86,11 +100,15 @@ func (p *Player) IsHost() bool {
 	return p.Role == RoleHost
 }
 
+func (p *Player) IsSpectator() bool {
+	return p.Role == RoleSpectator
+}
+
 func (p *Player) CanPressButton() bool {
-	return p.IsActive && !p.IsHost()
+	return p.IsActive && p.Role.IsPlayer()
 }
 
 func (p *Player) CanAnswer() bool {
-	return p.IsActive && !p.IsHost()
+	return p.IsActive && p.Role.IsPlayer()
 }
 
@@ -3,8 +3,9 @@ package player
 type Role string
 
 const (
-	RoleHost   Role = "host"
-	RolePlayer Role = "player"
+	RoleHost      Role = "host"
+	RolePlayer    Role = "player"
+	RoleSpectator Role = "spectator"
 )
 
 func (r Role) String() string {
@@ -19,3 +20,7 @@ func (r Role) IsPlayer() bool {
 	return r == RolePlayer
 }
 
+func (r Role) IsSpectator() bool {
+	return r == RoleSpectator
+}
+
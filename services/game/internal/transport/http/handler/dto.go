@@ -19,8 +19,40 @@ type PlayerInfo struct {
 }
 
 type GameSettings struct {
-	TimeForAnswer int `json:"time_for_answer" binding:"required"`
-	TimeForChoice int `json:"time_for_choice" binding:"required"`
+	TimeForAnswer  int     `json:"time_for_answer" binding:"required"`
+	TimeForChoice  int     `json:"time_for_choice" binding:"required"`
+	AnswerMatch    string  `json:"answer_match,omitempty"`
+	MatchThreshold float64 `json:"match_threshold,omitempty"`
+	ReopenButtons  bool    `json:"reopen_buttons,omitempty"`
+
+	FalseStartLockoutMs int `json:"false_start_lockout_ms,omitempty"`
+	ReadingSpeed        int `json:"reading_speed,omitempty"`
+
+	SelectionPolicy string `json:"selection_policy,omitempty"`
+	AnswerRevealMs  int    `json:"answer_reveal_ms,omitempty"`
+
+	Scoring ScoringRules `json:"scoring"`
+
+	RoundsOverviewTime int `json:"rounds_overview_time,omitempty"`
+	RoundIntroTime     int `json:"round_intro_time,omitempty"`
+	JudgingTime        int `json:"judging_time,omitempty"`
+	SecretTransferTime int `json:"secret_transfer_time,omitempty"`
+	StakeBettingTime   int `json:"stake_betting_time,omitempty"`
+	ButtonWindowMs     int `json:"button_window_ms,omitempty"`
+	ResultsDisplayTime int `json:"results_display_time,omitempty"`
+
+	SecretPriceSelectTime int `json:"secret_price_select_time,omitempty"`
+	RoundEndTime          int `json:"round_end_time,omitempty"`
+	FinalStakeTime        int `json:"final_stake_time,omitempty"`
+	FinalAnswerTime       int `json:"final_answer_time,omitempty"`
+}
+
+type ScoringRules struct {
+	AllowNegative      bool      `json:"allow_negative,omitempty"`
+	WrongAnswerPenalty *float64  `json:"wrong_answer_penalty,omitempty"`
+	NoTimeoutPenalty   bool      `json:"no_timeout_penalty,omitempty"`
+	RoundMultipliers   []float64 `json:"round_multipliers,omitempty"`
+	NoForAllPenalty    bool      `json:"no_for_all_penalty,omitempty"`
 }
 
 type CreateGameResponse struct {
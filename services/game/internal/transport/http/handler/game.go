@@ -6,6 +6,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	appGame "sigame/game/internal/application/game"
+	"sigame/game/internal/core/clock"
 	domainGame "sigame/game/internal/domain/game"
 	"sigame/game/internal/domain/player"
 	"sigame/game/internal/port"
@@ -77,10 +78,7 @@ func (h *GameHandler) CreateGame(c *gin.Context) {
 		return
 	}
 
-	settings := domainGame.Settings{
-		TimeForAnswer: req.Settings.TimeForAnswer,
-		TimeForChoice: req.Settings.TimeForChoice,
-	}
+	settings := toDomainSettings(req.Settings)
 
 	if err := settings.Validate(); err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{"error": ErrorInvalidSettings})
@@ -114,7 +112,7 @@ func (h *GameHandler) CreateGame(c *gin.Context) {
 		return
 	}
 
-	manager := appGame.New(game, pack, h.hub, h.eventLogger, h.gameRepository, h.gameCache)
+	manager := appGame.New(game, pack, h.hub, h.eventLogger, h.gameRepository, h.gameCache, clock.Real())
 	h.hub.RegisterGameManager(game.ID, manager)
 	manager.Start()
 
@@ -162,10 +160,7 @@ func (h *GameHandler) GetGame(c *gin.Context) {
 		Status:       string(game.Status),
 		CurrentRound: game.CurrentRound,
 		Players:      players,
-		Settings: GameSettings{
-			TimeForAnswer: game.Settings.TimeForAnswer,
-			TimeForChoice: game.Settings.TimeForChoice,
-		},
+		Settings:     toSettingsResponse(game.Settings),
 	})
 }
 
@@ -213,10 +208,7 @@ func (h *GameHandler) GetMyActiveGame(c *gin.Context) {
 			Status:       string(game.Status),
 			CurrentRound: game.CurrentRound,
 			Players:      players,
-			Settings: GameSettings{
-				TimeForAnswer: game.Settings.TimeForAnswer,
-				TimeForChoice: game.Settings.TimeForChoice,
-			},
+			Settings:     toSettingsResponse(game.Settings),
 		},
 	})
 }
@@ -143,6 +143,57 @@ func TestGameHandler_CreateGame(t *testing.T) {
 			},
 			expectedStatus: http.StatusBadRequest,
 		},
+		{
+			name: "unknown answer matcher",
+			requestBody: CreateGameRequest{
+				RoomID: uuid.New(),
+				PackID: uuid.New(),
+				Players: []PlayerInfo{
+					{UserID: uuid.New(), Username: "host", Role: "host"},
+					{UserID: uuid.New(), Username: "player", Role: "player"},
+				},
+				Settings: GameSettings{
+					TimeForAnswer: 30,
+					TimeForChoice: 20,
+					AnswerMatch:   "telepathy",
+				},
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "judging time out of range",
+			requestBody: CreateGameRequest{
+				RoomID: uuid.New(),
+				PackID: uuid.New(),
+				Players: []PlayerInfo{
+					{UserID: uuid.New(), Username: "host", Role: "host"},
+					{UserID: uuid.New(), Username: "player", Role: "player"},
+				},
+				Settings: GameSettings{
+					TimeForAnswer: 30,
+					TimeForChoice: 20,
+					JudgingTime:   1,
+				},
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
+		{
+			name: "final answer time out of range",
+			requestBody: CreateGameRequest{
+				RoomID: uuid.New(),
+				PackID: uuid.New(),
+				Players: []PlayerInfo{
+					{UserID: uuid.New(), Username: "host", Role: "host"},
+					{UserID: uuid.New(), Username: "player", Role: "player"},
+				},
+				Settings: GameSettings{
+					TimeForAnswer:   30,
+					TimeForChoice:   20,
+					FinalAnswerTime: 600,
+				},
+			},
+			expectedStatus: http.StatusBadRequest,
+		},
 	}
 
 	for _, tt := range tests {
@@ -180,8 +231,11 @@ func TestGameHandler_GetGame(t *testing.T) {
 		CurrentRound: 0,
 		Players:      make(map[uuid.UUID]*player.Player),
 		Settings: domainGame.Settings{
-			TimeForAnswer: 30,
-			TimeForChoice: 20,
+			TimeForAnswer:  30,
+			TimeForChoice:  20,
+			JudgingTime:    45,
+			ButtonWindowMs: 200,
+			FinalStakeTime: 40,
 		},
 	}
 
@@ -203,5 +257,14 @@ func TestGameHandler_GetGame(t *testing.T) {
 	handler.GetGame(c)
 
 	assert.Equal(t, http.StatusOK, w.Code)
+
+	var response GetGameResponse
+	assert.NoError(t, json.Unmarshal(w.Body.Bytes(), &response))
+	assert.Equal(t, 45, response.Settings.JudgingTime)
+	assert.Equal(t, 200, response.Settings.ButtonWindowMs)
+	assert.Equal(t, domainGame.DefaultRoundIntroTime, response.Settings.RoundIntroTime)
+	assert.Equal(t, 40, response.Settings.FinalStakeTime)
+	assert.Equal(t, domainGame.DefaultRoundEndTime, response.Settings.RoundEndTime)
+	assert.Contains(t, w.Body.String(), `"scoring":{}`)
 }
 
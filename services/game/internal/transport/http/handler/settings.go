@@ -0,0 +1,69 @@
+package handler
+
+import (
+	"time"
+
+	domainGame "sigame/game/internal/domain/game"
+)
+
+func toDomainSettings(s GameSettings) domainGame.Settings {
+	return domainGame.Settings{
+		TimeForAnswer:  s.TimeForAnswer,
+		TimeForChoice:  s.TimeForChoice,
+		AnswerMatch:    domainGame.AnswerMatchStrategy(s.AnswerMatch),
+		MatchThreshold: s.MatchThreshold,
+		ReopenButtons:  s.ReopenButtons,
+
+		FalseStartLockoutMs: s.FalseStartLockoutMs,
+		ReadingSpeed:        s.ReadingSpeed,
+
+		SelectionPolicy: domainGame.SelectionPolicy(s.SelectionPolicy),
+		AnswerRevealMs:  s.AnswerRevealMs,
+
+		Scoring: domainGame.ScoringRules(s.Scoring),
+
+		RoundsOverviewTime: s.RoundsOverviewTime,
+		RoundIntroTime:     s.RoundIntroTime,
+		JudgingTime:        s.JudgingTime,
+		SecretTransferTime: s.SecretTransferTime,
+		StakeBettingTime:   s.StakeBettingTime,
+		ButtonWindowMs:     s.ButtonWindowMs,
+		ResultsDisplayTime: s.ResultsDisplayTime,
+
+		SecretPriceSelectTime: s.SecretPriceSelectTime,
+		RoundEndTime:          s.RoundEndTime,
+		FinalStakeTime:        s.FinalStakeTime,
+		FinalAnswerTime:       s.FinalAnswerTime,
+	}
+}
+
+func toSettingsResponse(s domainGame.Settings) GameSettings {
+	return GameSettings{
+		TimeForAnswer:  s.TimeForAnswer,
+		TimeForChoice:  s.TimeForChoice,
+		AnswerMatch:    s.GetAnswerMatch().String(),
+		MatchThreshold: s.GetMatchThreshold(),
+		ReopenButtons:  s.ReopenButtons,
+
+		FalseStartLockoutMs: s.FalseStartLockoutMs,
+		ReadingSpeed:        s.GetReadingSpeed(),
+
+		SelectionPolicy: s.GetSelectionPolicy().String(),
+		AnswerRevealMs:  int(s.GetAnswerRevealDuration().Milliseconds()),
+
+		Scoring: ScoringRules(s.Scoring),
+
+		RoundsOverviewTime: int(s.GetRoundsOverviewDuration() / time.Second),
+		RoundIntroTime:     int(s.GetRoundIntroDuration() / time.Second),
+		JudgingTime:        int(s.GetJudgingDuration() / time.Second),
+		SecretTransferTime: int(s.GetSecretTransferDuration() / time.Second),
+		StakeBettingTime:   int(s.GetStakeBettingDuration() / time.Second),
+		ButtonWindowMs:     int(s.GetButtonWindow().Milliseconds()),
+		ResultsDisplayTime: int(s.GetResultsDisplayDuration() / time.Second),
+
+		SecretPriceSelectTime: int(s.GetSecretPriceSelectDuration() / time.Second),
+		RoundEndTime:          int(s.GetRoundEndDuration() / time.Second),
+		FinalStakeTime:        int(s.GetFinalStakeDuration() / time.Second),
+		FinalAnswerTime:       int(s.GetFinalAnswerDuration() / time.Second),
+	}
+}
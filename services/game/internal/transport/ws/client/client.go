@@ -12,6 +12,8 @@ import (
 type Hub interface {
 	Unregister(client interface{ GetUserID() uuid.UUID; GetGameID() uuid.UUID; GetRTT() time.Duration; Send([]byte) })
 	HandleMessage(client interface{ GetUserID() uuid.UUID; GetGameID() uuid.UUID; GetRTT() time.Duration; Send([]byte) }, msgData interface{})
+	ReportRTT(client interface{ GetUserID() uuid.UUID; GetGameID() uuid.UUID; GetRTT() time.Duration; Send([]byte) })
+	Resume(client interface{ GetUserID() uuid.UUID; GetGameID() uuid.UUID; GetRTT() time.Duration; Send([]byte) }, lastSeq uint64)
 }
 
 type Client struct {
@@ -34,14 +36,18 @@ func NewClient(hub Hub, conn *websocket.Conn, userID, gameID uuid.UUID) *Client
 	}
 }
 
-func (c *Client) UpdateRTT(rtt time.Duration) {
-	c.rtt.UpdateRTT(rtt, c.userID)
+func (c *Client) UpdateRTT(rtt time.Duration) bool {
+	return c.rtt.UpdateRTT(rtt, c.userID)
 }
 
 func (c *Client) GetRTT() time.Duration {
 	return c.rtt.GetRTT()
 }
 
+func (c *Client) GetClockOffset() (time.Duration, bool) {
+	return c.rtt.GetClockOffset()
+}
+
 func (c *Client) SetLastPingSentAt(t time.Time) {
 	c.rtt.SetLastPingSentAt(t)
 }
@@ -78,11 +84,59 @@ func (c *Client) readPump() {
 			continue
 		}
 
-		logger.Infof(nil, "[Client] Parsed message: type=%s, user_id=%s, game_id=%s, payload=%v", clientMsg.GetType(), clientMsg.UserID, clientMsg.GameID, clientMsg.GetPayload())
+		if clientMsg.Type == message.MessageTypePong {
+			c.handlePong(clientMsg, time.Now())
+			continue
+		}
+
+		if clientMsg.Type == message.MessageTypeResume {
+			c.handleResume(clientMsg)
+			continue
+		}
+
+		logger.Infof(nil, "[Client] Parsed message: type=%s, user_id=%s, game_id=%s, payload=%s", clientMsg.GetType(), clientMsg.UserID, clientMsg.GameID, clientMsg.Payload)
 		c.hub.HandleMessage(c, clientMsg)
 	}
 }
 
+func (c *Client) handlePong(msg *message.ClientMessage, receivedAt time.Time) {
+	var pong message.PongPayload
+	if err := msg.DecodePayload(&pong); err != nil {
+		logger.Warnf(nil, "[PONG] Invalid pong from %s: %v", c.userID, err)
+		return
+	}
+
+	sentAt, ok := c.rtt.AckPing(pong.ServerTime)
+	if !ok {
+		logger.Warnf(nil, "[PONG] Pong from %s does not match the last ping (server_time=%d)", c.userID, pong.ServerTime)
+		return
+	}
+
+	rtt := receivedAt.Sub(sentAt)
+	if !c.UpdateRTT(rtt) {
+		logger.Warnf(nil, "[PONG] Ignored RTT sample %v from %s", rtt, c.userID)
+		return
+	}
+
+	if pong.ClientTime > 0 {
+		offset := time.UnixMilli(pong.ClientTime).Sub(sentAt.Add(rtt / 2))
+		c.rtt.UpdateClockOffset(rtt, offset)
+	}
+
+	c.hub.ReportRTT(c)
+}
+
+func (c *Client) handleResume(msg *message.ClientMessage) {
+	var resume message.ResumePayload
+	if err := msg.DecodePayload(&resume); err != nil {
+		logger.Warnf(nil, "[RESUME] Invalid resume from %s: %v", c.userID, err)
+		return
+	}
+
+	logger.Infof(nil, "[RESUME] Client %s resumes after seq %d", c.userID, resume.LastSeq)
+	c.hub.Resume(c, resume.LastSeq)
+}
+
 func (c *Client) writePump() {
 	jsonPingTicker := time.NewTicker(JSONPingPeriod)
 	defer func() {
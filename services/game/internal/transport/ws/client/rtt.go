@@ -1,26 +1,43 @@
 package client
 
 import (
+	"sort"
 	"sync"
 	"time"
 )
 
-const MaxRTTSamples = 10
+const (
+	MaxRTTSamples = 10
+	MaxRTTSample  = 2 * time.Second
+)
+
+type clockSample struct {
+	rtt    time.Duration
+	offset time.Duration
+}
 
 type RTTTracker struct {
-	samples     []time.Duration
-	avgRTT      time.Duration
-	lastPingAt  time.Time
-	mu          sync.RWMutex
+	samples      []time.Duration
+	median       time.Duration
+	clockSamples []clockSample
+	offset       time.Duration
+	synced       bool
+	lastPingAt   time.Time
+	mu           sync.RWMutex
 }
 
 func newRTTTracker() *RTTTracker {
 	return &RTTTracker{
-		samples: make([]time.Duration, 0, MaxRTTSamples),
+		samples:      make([]time.Duration, 0, MaxRTTSamples),
+		clockSamples: make([]clockSample, 0, MaxRTTSamples),
 	}
 }
 
-func (r *RTTTracker) UpdateRTT(rtt time.Duration, userID interface{}) {
+func (r *RTTTracker) UpdateRTT(rtt time.Duration, userID interface{}) bool {
+	if rtt <= 0 || rtt > MaxRTTSample {
+		return false
+	}
+
 	r.mu.Lock()
 	defer r.mu.Unlock()
 
@@ -30,17 +47,45 @@ func (r *RTTTracker) UpdateRTT(rtt time.Duration, userID interface{}) {
 		r.samples = r.samples[1:]
 	}
 
-	var total time.Duration
-	for _, sample := range r.samples {
-		total += sample
-	}
-	r.avgRTT = total / time.Duration(len(r.samples))
+	r.median = medianRTT(r.samples)
+	return true
 }
 
 func (r *RTTTracker) GetRTT() time.Duration {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
-	return r.avgRTT
+	return r.median
+}
+
+func (r *RTTTracker) UpdateClockOffset(rtt, offset time.Duration) bool {
+	if rtt <= 0 || rtt > MaxRTTSample {
+		return false
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.clockSamples = append(r.clockSamples, clockSample{rtt: rtt, offset: offset})
+
+	if len(r.clockSamples) > MaxRTTSamples {
+		r.clockSamples = r.clockSamples[1:]
+	}
+
+	best := r.clockSamples[0]
+	for _, sample := range r.clockSamples[1:] {
+		if sample.rtt < best.rtt {
+			best = sample
+		}
+	}
+	r.offset = best.offset
+	r.synced = true
+	return true
+}
+
+func (r *RTTTracker) GetClockOffset() (time.Duration, bool) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.offset, r.synced
 }
 
 func (r *RTTTracker) SetLastPingSentAt(t time.Time) {
@@ -55,3 +100,29 @@ func (r *RTTTracker) GetLastPingSentAt() time.Time {
 	return r.lastPingAt
 }
 
+func (r *RTTTracker) AckPing(serverTime int64) (time.Time, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.lastPingAt.IsZero() || r.lastPingAt.UnixMilli() != serverTime {
+		return time.Time{}, false
+	}
+
+	sentAt := r.lastPingAt
+	r.lastPingAt = time.Time{}
+	return sentAt, true
+}
+
+func medianRTT(samples []time.Duration) time.Duration {
+	sorted := make([]time.Duration, len(samples))
+	copy(sorted, samples)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+	return sorted[mid]
+}
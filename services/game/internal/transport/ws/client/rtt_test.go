@@ -3,6 +3,9 @@ package client
 import (
 	"testing"
 	"time"
+
+	"github.com/google/uuid"
+	"sigame/game/internal/transport/ws/message"
 )
 
 func TestRTTTracker_UpdateRTT(t *testing.T) {
@@ -57,3 +60,147 @@ func TestRTTTracker_MaxSamples(t *testing.T) {
 	}
 }
 
+
+func TestRTTTracker_MedianIgnoresOutliers(t *testing.T) {
+	tracker := newRTTTracker()
+
+	for _, ms := range []int{40, 42, 2000, 41, 39, 43, 1500} {
+		tracker.UpdateRTT(time.Duration(ms)*time.Millisecond, "user1")
+	}
+
+	if got := tracker.GetRTT(); got != 42*time.Millisecond {
+		t.Errorf("GetRTT() = %v, want 42ms", got)
+	}
+}
+
+func TestRTTTracker_RejectsInvalidSamples(t *testing.T) {
+	tracker := newRTTTracker()
+
+	for _, rtt := range []time.Duration{0, -5 * time.Millisecond, MaxRTTSample + time.Second} {
+		if tracker.UpdateRTT(rtt, "user1") {
+			t.Errorf("UpdateRTT(%v) = true, want false", rtt)
+		}
+	}
+	if tracker.GetRTT() != 0 {
+		t.Errorf("GetRTT() = %v, want 0 after invalid samples", tracker.GetRTT())
+	}
+}
+
+func TestRTTTracker_AckPing(t *testing.T) {
+	tracker := newRTTTracker()
+	sentAt := time.UnixMilli(1700000000000)
+
+	if _, ok := tracker.AckPing(sentAt.UnixMilli()); ok {
+		t.Error("AckPing() = true before any ping was sent")
+	}
+
+	tracker.SetLastPingSentAt(sentAt)
+	if _, ok := tracker.AckPing(sentAt.UnixMilli() - 500); ok {
+		t.Error("AckPing() = true for a server_time that was never sent")
+	}
+
+	got, ok := tracker.AckPing(sentAt.UnixMilli())
+	if !ok || !got.Equal(sentAt) {
+		t.Errorf("AckPing() = %v, %v, want %v, true", got, ok, sentAt)
+	}
+	if _, ok := tracker.AckPing(sentAt.UnixMilli()); ok {
+		t.Error("AckPing() = true for a ping that was already acknowledged")
+	}
+}
+
+func TestRTTTracker_ClockOffsetUsesFastestSample(t *testing.T) {
+	tracker := newRTTTracker()
+
+	if _, synced := tracker.GetClockOffset(); synced {
+		t.Fatal("GetClockOffset() synced = true before any sample")
+	}
+
+	tracker.UpdateClockOffset(120*time.Millisecond, 90*time.Millisecond)
+	tracker.UpdateClockOffset(40*time.Millisecond, 250*time.Millisecond)
+	tracker.UpdateClockOffset(300*time.Millisecond, -400*time.Millisecond)
+
+	if tracker.UpdateClockOffset(0, time.Hour) {
+		t.Error("UpdateClockOffset(0) = true, want false")
+	}
+
+	offset, synced := tracker.GetClockOffset()
+	if !synced || offset != 250*time.Millisecond {
+		t.Errorf("GetClockOffset() = %v, %v, want 250ms, true", offset, synced)
+	}
+}
+
+type rttHub struct {
+	reported []time.Duration
+}
+
+func (h *rttHub) Unregister(client interface {
+	GetUserID() uuid.UUID
+	GetGameID() uuid.UUID
+	GetRTT() time.Duration
+	Send([]byte)
+}) {
+}
+
+func (h *rttHub) HandleMessage(client interface {
+	GetUserID() uuid.UUID
+	GetGameID() uuid.UUID
+	GetRTT() time.Duration
+	Send([]byte)
+}, msgData interface{}) {
+}
+
+func (h *rttHub) ReportRTT(client interface {
+	GetUserID() uuid.UUID
+	GetGameID() uuid.UUID
+	GetRTT() time.Duration
+	Send([]byte)
+}) {
+	h.reported = append(h.reported, client.GetRTT())
+}
+
+func (h *rttHub) Resume(client interface {
+	GetUserID() uuid.UUID
+	GetGameID() uuid.UUID
+	GetRTT() time.Duration
+	Send([]byte)
+}, lastSeq uint64) {
+}
+
+func TestClient_HandlePong(t *testing.T) {
+	hub := &rttHub{}
+	c := NewClient(hub, nil, uuid.New(), uuid.New())
+	sentAt := time.UnixMilli(1700000000000)
+
+	pong := func(payload string) *message.ClientMessage {
+		msg, err := message.NewClientMessage([]byte(`{"type":"PONG","payload":` + payload + `}`))
+		if err != nil {
+			t.Fatalf("NewClientMessage() error = %v", err)
+		}
+		return msg
+	}
+
+	c.handlePong(pong(`{"server_time":1700000000000,"client_time":1700000000030}`), sentAt.Add(60*time.Millisecond))
+
+	c.SetLastPingSentAt(sentAt)
+	c.handlePong(pong(`{"server_time":1700000000000,"client_time":1700000000030}`), sentAt.Add(60*time.Millisecond))
+	c.handlePong(pong(`{"server_time":1700000000000,"client_time":1700000000030}`), sentAt.Add(900*time.Millisecond))
+
+	c.SetLastPingSentAt(sentAt)
+	c.handlePong(pong(`{"client_time":1700000000030}`), sentAt.Add(60*time.Millisecond))
+	c.handlePong(pong(`{"server_time":1699999999000,"client_time":1700000000030}`), sentAt.Add(60*time.Millisecond))
+	c.handlePong(pong(`{"server_time":1700000000000}`), sentAt.Add(-time.Second))
+
+	if len(hub.reported) != 1 || hub.reported[0] != 60*time.Millisecond {
+		t.Errorf("reported RTTs = %v, want [60ms]", hub.reported)
+	}
+	if c.GetRTT() != 60*time.Millisecond {
+		t.Errorf("GetRTT() = %v, want 60ms", c.GetRTT())
+	}
+
+	c.SetLastPingSentAt(sentAt)
+	c.handlePong(pong(`{"server_time":1700000000000,"client_time":1700000002025}`), sentAt.Add(50*time.Millisecond))
+
+	if offset, synced := c.GetClockOffset(); !synced || offset != 2*time.Second {
+		t.Errorf("GetClockOffset() = %v, %v, want 2s, true", offset, synced)
+	}
+}
@@ -41,6 +41,7 @@ func (h *Handler) HandleWebSocket(c *gin.Context) {
 
 	token := c.Query(QueryParamToken)
 	var userID uuid.UUID
+	username := c.Query(QueryParamUsername)
 
 	if token != "" {
 		if h.authClient == nil {
@@ -63,6 +64,9 @@ func (h *Handler) HandleWebSocket(c *gin.Context) {
 		}
 
 		userID = resp.UserID
+		if resp.Username != "" {
+			username = resp.Username
+		}
 	} else {
 		userIDStr := c.Query(QueryParamUserID)
 		if userIDStr == "" {
@@ -80,12 +84,23 @@ func (h *Handler) HandleWebSocket(c *gin.Context) {
 		userID = parsedUserID
 	}
 
-	if _, exists := h.hub.GetGameManager(gameID); !exists {
+	manager, exists := h.hub.GetGameManager(gameID)
+	if !exists {
 		logger.Errorf(ctx, "[WS] Game manager not found for game %s", gameID)
 		c.JSON(http.StatusNotFound, gin.H{"error": ErrorGameNotFound})
 		return
 	}
 
+	if username == "" {
+		username = userID.String()
+	}
+
+	if err := manager.AdmitUser(userID, username); err != nil {
+		logger.Warnf(ctx, "[WS] User %s not admitted to game %s: %v", userID, gameID, err)
+		c.JSON(http.StatusForbidden, gin.H{"error": ErrorGameNotRunning})
+		return
+	}
+
 	conn, err := Upgrader.Upgrade(c.Writer, c.Request, nil)
 	if err != nil {
 		logger.Errorf(ctx, "[WS] Failed to upgrade connection: %v", err)
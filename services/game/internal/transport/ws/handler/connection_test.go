@@ -5,12 +5,14 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	authClient "sigame/game/internal/adapter/grpc/auth"
+	domainGame "sigame/game/internal/domain/game"
 	"sigame/game/internal/transport/ws/hub"
 )
 
@@ -30,6 +32,11 @@ type MockGameManager struct {
 	mock.Mock
 }
 
+func (m *MockGameManager) AdmitUser(userID uuid.UUID, username string) error {
+	args := m.Called(userID, username)
+	return args.Error(0)
+}
+
 func (m *MockGameManager) HandleClientMessage(userID uuid.UUID, message interface{}) {
 	m.Called(userID, message)
 }
@@ -42,6 +49,14 @@ func (m *MockGameManager) SetPlayerConnected(userID uuid.UUID, connected bool) {
 	m.Called(userID, connected)
 }
 
+func (m *MockGameManager) SetPlayerRTT(userID uuid.UUID, rtt time.Duration) {
+	m.Called(userID, rtt)
+}
+
+func (m *MockGameManager) SetPlayerClockOffset(userID uuid.UUID, offset time.Duration) {
+	m.Called(userID, offset)
+}
+
 func (m *MockGameManager) Stop() {
 	m.Called()
 }
@@ -54,6 +69,7 @@ func TestHandler_HandleWebSocket_WithValidToken(t *testing.T) {
 
 	mockHub := hub.New()
 	mockManager := new(MockGameManager)
+	mockManager.On("AdmitUser", userID, userID.String()).Return(nil)
 	mockHub.RegisterGameManager(gameID, mockManager)
 
 	mockAuthClient := new(MockAuthClient)
@@ -111,6 +127,7 @@ func TestHandler_HandleWebSocket_WithUserIDFallback(t *testing.T) {
 
 	mockHub := hub.New()
 	mockManager := new(MockGameManager)
+	mockManager.On("AdmitUser", userID, userID.String()).Return(nil)
 	mockHub.RegisterGameManager(gameID, mockManager)
 
 	h := NewHandler(mockHub, nil)
@@ -191,3 +208,27 @@ func TestHandler_HandleWebSocket_NoTokenOrUserID(t *testing.T) {
 	assert.Equal(t, http.StatusBadRequest, w.Code)
 }
 
+
+func TestHandler_HandleWebSocket_SpectatorRejectedWhenGameNotRunning(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	gameID := uuid.New()
+	userID := uuid.New()
+
+	mockHub := hub.New()
+	mockManager := new(MockGameManager)
+	mockManager.On("AdmitUser", userID, "streamer").Return(domainGame.ErrGameNotRunning)
+	mockHub.RegisterGameManager(gameID, mockManager)
+
+	h := NewHandler(mockHub, nil)
+
+	w := httptest.NewRecorder()
+	c, _ := gin.CreateTestContext(w)
+	c.Request = httptest.NewRequest(http.MethodGet, "/ws/game/"+gameID.String()+"?user_id="+userID.String()+"&username=streamer", nil)
+	c.Params = gin.Params{{Key: "id", Value: gameID.String()}}
+
+	h.HandleWebSocket(c)
+
+	assert.Equal(t, http.StatusForbidden, w.Code)
+	mockManager.AssertExpectations(t)
+}
@@ -9,12 +9,14 @@ import (
 const (
 	QueryParamUserID   = "user_id"
 	QueryParamToken   = "token"
+	QueryParamUsername = "username"
 	ErrorInvalidGameID = "Invalid game ID"
 	ErrorUserIDRequired = "user_id is required"
 	ErrorInvalidUserID  = "Invalid user ID"
 	ErrorTokenRequired  = "token is required"
 	ErrorInvalidToken   = "Invalid or expired token"
 	ErrorGameNotFound   = "Game not found or not started"
+	ErrorGameNotRunning = "Game is not running, spectators cannot join"
 )
 
 var Upgrader = websocket.Upgrader{
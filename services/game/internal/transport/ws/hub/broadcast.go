@@ -1,47 +1,109 @@
 package hub
 
-import "github.com/google/uuid"
+import (
+	"github.com/google/uuid"
+	"sigame/game/internal/infrastructure/logger"
+	"sigame/game/internal/transport/ws/message"
+)
 
-func (h *Hub) broadcastToGame(gameID uuid.UUID, message []byte) {
-	h.mu.RLock()
-	clients := h.games[gameID]
-	h.mu.RUnlock()
+func (m *BroadcastMessage) render(userID uuid.UUID) *message.ServerMessage {
+	if m.Render != nil {
+		return m.Render(userID)
+	}
+	return m.Message
+}
+
+func (m *BroadcastMessage) transient() bool {
+	return m.Message != nil && m.Message.Transient
+}
+
+type encoder struct {
+	seq     uint64
+	encoded map[*message.ServerMessage][]byte
+}
 
-	for client := range clients {
-		client.Send(message)
+func newEncoder(seq uint64) *encoder {
+	return &encoder{
+		seq:     seq,
+		encoded: make(map[*message.ServerMessage][]byte),
 	}
 }
 
-func (h *Hub) BroadcastToUser(gameID, userID uuid.UUID, message []byte) {
+func (e *encoder) encode(msg *message.ServerMessage) []byte {
+	if msg == nil {
+		return nil
+	}
+	if data, ok := e.encoded[msg]; ok {
+		return data
+	}
+
+	stamped := *msg
+	stamped.Seq = e.seq
+	data, err := stamped.ToJSON()
+	if err != nil {
+		logger.Errorf(nil, "[Hub] Failed to marshal %s: %v", msg.Type, err)
+	}
+	e.encoded[msg] = data
+	return data
+}
+
+func (h *Hub) deliver(msg *BroadcastMessage) {
+	clients := h.clientsOf(msg.GameID, nil)
+
 	h.mu.RLock()
-	defer h.mu.RUnlock()
+	buffer := h.replays[msg.GameID]
+	h.mu.RUnlock()
 
-	clients, ok := h.games[gameID]
-	if !ok {
-		return
+	var seq uint64
+	if buffer != nil && !msg.transient() {
+		seq = buffer.append(msg.render)
 	}
 
-	for client := range clients {
-		if client.GetUserID() == userID {
-			client.Send(message)
-			return
+	enc := newEncoder(seq)
+	for _, client := range clients {
+		if data := enc.encode(msg.render(client.GetUserID())); data != nil {
+			send(client, data)
 		}
 	}
 }
 
-func (h *Hub) BroadcastExcept(gameID, exceptUserID uuid.UUID, message []byte) {
+func send(client Client, data []byte) {
+	defer func() {
+		if r := recover(); r != nil {
+			logger.Warnf(nil, "[Hub] Failed to send to %s: %v", client.GetUserID(), r)
+		}
+	}()
+	client.Send(data)
+}
+
+func (h *Hub) clientsOf(gameID uuid.UUID, userID *uuid.UUID) []Client {
 	h.mu.RLock()
 	defer h.mu.RUnlock()
 
-	clients, ok := h.games[gameID]
-	if !ok {
-		return
+	clients := make([]Client, 0, len(h.games[gameID]))
+	for client := range h.games[gameID] {
+		if userID == nil || client.GetUserID() == *userID {
+			clients = append(clients, client)
+		}
 	}
+	return clients
+}
 
-	for client := range clients {
-		if client.GetUserID() != exceptUserID {
-			client.Send(message)
-		}
+func (h *Hub) BroadcastToUser(gameID, userID uuid.UUID, msg *message.ServerMessage) {
+	data := newEncoder(0).encode(msg)
+	if data == nil {
+		return
+	}
+	for _, client := range h.clientsOf(gameID, &userID) {
+		send(client, data)
 	}
 }
 
+func (h *Hub) BroadcastExcept(gameID, exceptUserID uuid.UUID, msg *message.ServerMessage) {
+	h.BroadcastPersonalized(gameID, func(recipientID uuid.UUID) *message.ServerMessage {
+		if recipientID != exceptUserID {
+			return msg
+		}
+		return nil
+	})
+}
@@ -0,0 +1,3 @@
+package hub
+
+const ReplayBufferSize = 128
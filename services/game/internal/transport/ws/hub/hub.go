@@ -5,12 +5,16 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"sigame/game/internal/transport/ws/message"
 )
 
 type GameManager interface {
+	AdmitUser(userID uuid.UUID, username string) error
 	HandleClientMessage(userID uuid.UUID, message interface{})
 	SendStateToClient(client interface{})
 	SetPlayerConnected(userID uuid.UUID, connected bool)
+	SetPlayerRTT(userID uuid.UUID, rtt time.Duration)
+	SetPlayerClockOffset(userID uuid.UUID, offset time.Duration)
 	Stop()
 }
 
@@ -18,6 +22,7 @@ type Client interface {
 	GetUserID() uuid.UUID
 	GetGameID() uuid.UUID
 	GetRTT() time.Duration
+	GetClockOffset() (time.Duration, bool)
 	Send(data []byte)
 }
 
@@ -28,16 +33,24 @@ type ClientMessageWrapper struct {
 
 type BroadcastMessage struct {
 	GameID  uuid.UUID
-	Message []byte
+	Message *message.ServerMessage
+	Render  func(userID uuid.UUID) *message.ServerMessage
+}
+
+type ResumeRequest struct {
+	Client  Client
+	LastSeq uint64
 }
 
 type Hub struct {
 	games         map[uuid.UUID]map[Client]bool
 	managers      map[uuid.UUID]GameManager
+	replays       map[uuid.UUID]*replayBuffer
+	snapshots     map[Client]uint64
 	register      chan Client
 	unregister    chan Client
 	clientMessage chan *ClientMessageWrapper
-	broadcast     chan *BroadcastMessage
+	resume        chan *ResumeRequest
 	mu            sync.RWMutex
 }
 
@@ -45,10 +58,12 @@ func New() *Hub {
 	return &Hub{
 		games:         make(map[uuid.UUID]map[Client]bool),
 		managers:      make(map[uuid.UUID]GameManager),
+		replays:       make(map[uuid.UUID]*replayBuffer),
+		snapshots:     make(map[Client]uint64),
 		register:      make(chan Client),
 		unregister:    make(chan Client),
 		clientMessage: make(chan *ClientMessageWrapper),
-		broadcast:     make(chan *BroadcastMessage, 256),
+		resume:        make(chan *ResumeRequest),
 	}
 }
 
@@ -87,13 +102,13 @@ func (h *Hub) Run() {
 				h.handleClientMessage(wrapper)
 			}()
 
-		case msg := <-h.broadcast:
+		case req := <-h.resume:
 			func() {
 				defer func() {
 					if r := recover(); r != nil {
 					}
 				}()
-				h.broadcastToGame(msg.GameID, msg.Message)
+				h.resumeClient(req.Client, req.LastSeq)
 			}()
 		}
 	}
@@ -114,13 +129,42 @@ func (h *Hub) HandleMessage(cl interface{ GetUserID() uuid.UUID; GetGameID() uui
 	}
 }
 
-func (h *Hub) Broadcast(gameID uuid.UUID, message []byte) {
-	h.broadcast <- &BroadcastMessage{
-		GameID:  gameID,
-		Message: message,
+func (h *Hub) ReportRTT(cl interface{ GetUserID() uuid.UUID; GetGameID() uuid.UUID; GetRTT() time.Duration; Send([]byte) }) {
+	manager, exists := h.GetGameManager(cl.GetGameID())
+	if !exists {
+		return
+	}
+
+	manager.SetPlayerRTT(cl.GetUserID(), cl.GetRTT())
+
+	if client, ok := cl.(Client); ok {
+		if offset, synced := client.GetClockOffset(); synced {
+			manager.SetPlayerClockOffset(cl.GetUserID(), offset)
+		}
+	}
+}
+
+func (h *Hub) Resume(cl interface{ GetUserID() uuid.UUID; GetGameID() uuid.UUID; GetRTT() time.Duration; Send([]byte) }, lastSeq uint64) {
+	h.resume <- &ResumeRequest{
+		Client:  cl.(Client),
+		LastSeq: lastSeq,
 	}
 }
 
+func (h *Hub) Broadcast(gameID uuid.UUID, msg *message.ServerMessage) {
+	h.deliver(&BroadcastMessage{
+		GameID:  gameID,
+		Message: msg,
+	})
+}
+
+func (h *Hub) BroadcastPersonalized(gameID uuid.UUID, render func(userID uuid.UUID) *message.ServerMessage) {
+	h.deliver(&BroadcastMessage{
+		GameID: gameID,
+		Render: render,
+	})
+}
+
 func (h *Hub) handleClientMessage(wrapper *ClientMessageWrapper) {
 	gameID := wrapper.Client.GetGameID()
 	userID := wrapper.Client.GetUserID()
@@ -158,12 +202,16 @@ func (h *Hub) RegisterGameManager(gameID uuid.UUID, manager GameManager) {
 	h.mu.Lock()
 	defer h.mu.Unlock()
 	h.managers[gameID] = manager
+	if _, exists := h.replays[gameID]; !exists {
+		h.replays[gameID] = newReplayBuffer(ReplayBufferSize)
+	}
 }
 
 func (h *Hub) UnregisterGameManager(gameID uuid.UUID) {
 	h.mu.Lock()
 	defer h.mu.Unlock()
 	delete(h.managers, gameID)
+	delete(h.replays, gameID)
 }
 
 func (h *Hub) GetGameManager(gameID uuid.UUID) (GameManager, bool) {
@@ -175,11 +223,12 @@ func (h *Hub) GetGameManager(gameID uuid.UUID) (GameManager, bool) {
 
 func (h *Hub) Stop() {
 	h.mu.Lock()
-	defer h.mu.Unlock()
+	managers := h.managers
+	h.managers = make(map[uuid.UUID]GameManager)
+	h.replays = make(map[uuid.UUID]*replayBuffer)
+	h.mu.Unlock()
 
-	for _, manager := range h.managers {
+	for _, manager := range managers {
 		manager.Stop()
 	}
-
-	h.managers = make(map[uuid.UUID]GameManager)
 }
@@ -3,41 +3,62 @@ package hub
 import "github.com/google/uuid"
 
 func (h *Hub) registerClient(client Client) {
-	h.mu.Lock()
-	defer h.mu.Unlock()
-
 	gameID := client.GetGameID()
 
+	h.mu.Lock()
 	if _, exists := h.games[gameID]; !exists {
 		h.games[gameID] = make(map[Client]bool)
 	}
 
 	h.games[gameID][client] = true
 
-	if manager, exists := h.managers[gameID]; exists {
-		manager.SetPlayerConnected(client.GetUserID(), true)
-		go manager.SendStateToClient(client)
+	manager, exists := h.managers[gameID]
+	buffer := h.replays[gameID]
+	h.mu.Unlock()
+
+	if !exists {
+		return
 	}
+
+	manager.SetPlayerConnected(client.GetUserID(), true)
+
+	snapshot := &sequencedClient{Client: client}
+	if buffer != nil {
+		snapshot.seq = buffer.lastSeq()
+	}
+	h.mu.Lock()
+	h.snapshots[client] = snapshot.seq
+	h.mu.Unlock()
+
+	go manager.SendStateToClient(snapshot)
 }
 
 func (h *Hub) unregisterClient(cl Client) {
+	gameID := cl.GetGameID()
+
 	h.mu.Lock()
-	defer h.mu.Unlock()
+	clients, ok := h.games[gameID]
+	if !ok {
+		h.mu.Unlock()
+		return
+	}
+	if _, exists := clients[cl]; !exists {
+		h.mu.Unlock()
+		return
+	}
 
-	gameID := cl.GetGameID()
+	delete(clients, cl)
+	delete(h.snapshots, cl)
 
-	if clients, ok := h.games[gameID]; ok {
-		if _, exists := clients[cl]; exists {
-			delete(clients, cl)
+	if len(clients) == 0 {
+		delete(h.games, gameID)
+	}
 
-			if len(clients) == 0 {
-				delete(h.games, gameID)
-			}
+	manager, exists := h.managers[gameID]
+	h.mu.Unlock()
 
-			if manager, exists := h.managers[gameID]; exists {
-				manager.SetPlayerConnected(cl.GetUserID(), false)
-			}
-		}
+	if exists {
+		manager.SetPlayerConnected(cl.GetUserID(), false)
 	}
 }
 
@@ -0,0 +1,110 @@
+package hub
+
+import (
+	"sync"
+
+	"github.com/google/uuid"
+	"sigame/game/internal/transport/ws/message"
+)
+
+type replayEntry struct {
+	seq    uint64
+	render func(userID uuid.UUID) *message.ServerMessage
+}
+
+type replayBuffer struct {
+	entries []replayEntry
+	seq     uint64
+	size    int
+	mu      sync.Mutex
+}
+
+func newReplayBuffer(size int) *replayBuffer {
+	return &replayBuffer{
+		entries: make([]replayEntry, 0, size),
+		size:    size,
+	}
+}
+
+func (b *replayBuffer) append(render func(userID uuid.UUID) *message.ServerMessage) uint64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.seq++
+	b.entries = append(b.entries, replayEntry{seq: b.seq, render: render})
+
+	if len(b.entries) > b.size {
+		b.entries = b.entries[1:]
+	}
+
+	return b.seq
+}
+
+func (b *replayBuffer) since(lastSeq uint64) ([]replayEntry, bool) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if lastSeq > b.seq {
+		return nil, false
+	}
+	if lastSeq == b.seq {
+		return nil, true
+	}
+
+	oldest := b.seq - uint64(len(b.entries)) + 1
+	if len(b.entries) == 0 || lastSeq+1 < oldest {
+		return nil, false
+	}
+
+	missed := make([]replayEntry, len(b.entries)-int(lastSeq+1-oldest))
+	copy(missed, b.entries[lastSeq+1-oldest:])
+	return missed, true
+}
+
+func (b *replayBuffer) lastSeq() uint64 {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.seq
+}
+
+type sequencedClient struct {
+	Client
+	seq uint64
+}
+
+func (c *sequencedClient) SendMessage(msg *message.ServerMessage) {
+	if data := newEncoder(c.seq).encode(msg); data != nil {
+		c.Client.Send(data)
+	}
+}
+
+func (h *Hub) resumeClient(client Client, lastSeq uint64) {
+	gameID := client.GetGameID()
+
+	h.mu.RLock()
+	buffer, hasBuffer := h.replays[gameID]
+	manager, exists := h.managers[gameID]
+	snapshotSeq := h.snapshots[client]
+	h.mu.RUnlock()
+
+	if !hasBuffer || !exists {
+		return
+	}
+
+	missed, ok := buffer.since(lastSeq)
+	if !ok {
+		go manager.SendStateToClient(&sequencedClient{Client: client, seq: buffer.lastSeq()})
+		return
+	}
+
+	userID := client.GetUserID()
+	for _, entry := range missed {
+		msg := entry.render(userID)
+		if msg == nil || (entry.seq <= snapshotSeq && msg.Type == message.MessageTypeStateUpdate) {
+			continue
+		}
+		if data := newEncoder(entry.seq).encode(msg); data != nil {
+			client.Send(data)
+		}
+	}
+}
@@ -0,0 +1,258 @@
+package hub
+
+import (
+	"encoding/json"
+	"reflect"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"sigame/game/internal/transport/ws/message"
+)
+
+type fakeClient struct {
+	userID uuid.UUID
+	gameID uuid.UUID
+	sent   [][]byte
+}
+
+func (c *fakeClient) GetUserID() uuid.UUID                  { return c.userID }
+func (c *fakeClient) GetGameID() uuid.UUID                  { return c.gameID }
+func (c *fakeClient) GetRTT() time.Duration                 { return 0 }
+func (c *fakeClient) GetClockOffset() (time.Duration, bool) { return 0, false }
+func (c *fakeClient) Send(data []byte)                      { c.sent = append(c.sent, data) }
+
+func (c *fakeClient) received(t *testing.T) []string {
+	t.Helper()
+	result := make([]string, 0, len(c.sent))
+	for _, data := range c.sent {
+		var msg struct {
+			Seq  uint64 `json:"seq"`
+			Type string `json:"type"`
+		}
+		if err := json.Unmarshal(data, &msg); err != nil {
+			t.Fatalf("json.Unmarshal(%s) error = %v", data, err)
+		}
+		result = append(result, msg.Type+"#"+strconv.FormatUint(msg.Seq, 10))
+	}
+	return result
+}
+
+type fakeManager struct {
+	snapshots chan Client
+}
+
+func (m *fakeManager) AdmitUser(userID uuid.UUID, username string) error           { return nil }
+func (m *fakeManager) HandleClientMessage(userID uuid.UUID, message interface{})   {}
+func (m *fakeManager) SetPlayerConnected(userID uuid.UUID, connected bool)         {}
+func (m *fakeManager) SetPlayerRTT(userID uuid.UUID, rtt time.Duration)            {}
+func (m *fakeManager) SetPlayerClockOffset(userID uuid.UUID, offset time.Duration) {}
+func (m *fakeManager) Stop()                                                       {}
+
+func (m *fakeManager) SendStateToClient(client interface{}) {
+	client.(*sequencedClient).SendMessage(message.NewServerMessage(message.MessageTypeStateUpdate, struct{}{}))
+	m.snapshots <- client.(Client)
+}
+
+type broadcastingManager struct {
+	fakeManager
+	hub    *Hub
+	gameID uuid.UUID
+}
+
+func (m *broadcastingManager) SetPlayerConnected(userID uuid.UUID, connected bool) {
+	m.hub.Broadcast(m.gameID, message.NewServerMessage(message.MessageTypeStateUpdate, nil))
+}
+
+func TestReplayBuffer_Since(t *testing.T) {
+	buffer := newReplayBuffer(3)
+	render := func(uuid.UUID) *message.ServerMessage { return nil }
+
+	if _, ok := buffer.since(0); !ok {
+		t.Error("since(0) on empty buffer ok = false, want true")
+	}
+	for i := 0; i < 5; i++ {
+		buffer.append(render)
+	}
+
+	tests := []struct {
+		lastSeq  uint64
+		wantSeqs []uint64
+		wantOK   bool
+	}{
+		{lastSeq: 5, wantOK: true},
+		{lastSeq: 3, wantSeqs: []uint64{4, 5}, wantOK: true},
+		{lastSeq: 2, wantSeqs: []uint64{3, 4, 5}, wantOK: true},
+		{lastSeq: 1, wantOK: false},
+		{lastSeq: 9, wantOK: false},
+	}
+
+	for _, tt := range tests {
+		missed, ok := buffer.since(tt.lastSeq)
+		if ok != tt.wantOK {
+			t.Errorf("since(%d) ok = %v, want %v", tt.lastSeq, ok, tt.wantOK)
+			continue
+		}
+		if len(missed) != len(tt.wantSeqs) {
+			t.Errorf("since(%d) returned %d entries, want %d", tt.lastSeq, len(missed), len(tt.wantSeqs))
+			continue
+		}
+		for i, entry := range missed {
+			if entry.seq != tt.wantSeqs[i] {
+				t.Errorf("since(%d)[%d].seq = %d, want %d", tt.lastSeq, i, entry.seq, tt.wantSeqs[i])
+			}
+		}
+	}
+}
+
+func TestHub_ResumeReplaysMissedMessages(t *testing.T) {
+	h := New()
+	gameID := uuid.New()
+	h.RegisterGameManager(gameID, &fakeManager{snapshots: make(chan Client, 1)})
+
+	online := &fakeClient{userID: uuid.New(), gameID: gameID}
+	h.games[gameID] = map[Client]bool{online: true}
+	dropped := &fakeClient{userID: uuid.New(), gameID: gameID}
+
+	h.deliver(&BroadcastMessage{GameID: gameID, Message: message.NewServerMessage(message.MessageTypeQuestionSelected, nil)})
+	h.BroadcastToUser(gameID, dropped.userID, message.NewServerMessage(message.MessageTypeFalseStart, nil))
+	h.BroadcastToUser(gameID, online.userID, message.NewServerMessage(message.MessageTypeError, nil))
+	h.BroadcastPersonalized(gameID, func(userID uuid.UUID) *message.ServerMessage {
+		return message.NewServerMessage(message.MessageTypeStartMedia, map[string]string{"user": userID.String()})
+	})
+
+	want := []string{"QUESTION_SELECTED#1", "ERROR#0", "START_MEDIA#2"}
+	if got := online.received(t); !reflect.DeepEqual(got, want) {
+		t.Errorf("online client received %v, want %v", got, want)
+	}
+	if got := string(online.sent[1]); got != `{"type":"ERROR"}` {
+		t.Errorf("personal ERROR = %s, want it without seq", got)
+	}
+
+	h.resumeClient(dropped, 1)
+
+	want = []string{"START_MEDIA#2"}
+	if got := dropped.received(t); !reflect.DeepEqual(got, want) {
+		t.Errorf("resumed client received %v, want %v", got, want)
+	}
+
+	wantMedia := `{"type":"START_MEDIA","seq":2,"payload":{"user":"` + dropped.userID.String() + `"}}`
+	if got := string(dropped.sent[len(dropped.sent)-1]); got != wantMedia {
+		t.Errorf("replayed START_MEDIA = %s, want %s", got, wantMedia)
+	}
+}
+
+func TestHub_ResumeSkipsStateUpdatesCoveredBySnapshot(t *testing.T) {
+	h := New()
+	gameID := uuid.New()
+	manager := &fakeManager{snapshots: make(chan Client, 1)}
+	h.RegisterGameManager(gameID, manager)
+
+	client := &fakeClient{userID: uuid.New(), gameID: gameID}
+	h.deliver(&BroadcastMessage{GameID: gameID, Message: message.NewServerMessage(message.MessageTypeStateUpdate, nil)})
+	h.deliver(&BroadcastMessage{GameID: gameID, Message: message.NewServerMessage(message.MessageTypeStateUpdate, nil)})
+	h.deliver(&BroadcastMessage{GameID: gameID, Message: message.NewServerMessage(message.MessageTypeQuestionReveal, nil)})
+
+	h.registerClient(client)
+	select {
+	case <-manager.snapshots:
+	case <-time.After(time.Second):
+		t.Fatal("registerClient() did not request a snapshot")
+	}
+
+	h.resumeClient(client, 1)
+	h.deliver(&BroadcastMessage{GameID: gameID, Message: message.NewServerMessage(message.MessageTypeStateUpdate, nil)})
+
+	want := []string{"STATE_UPDATE#3", "QUESTION_REVEAL#3", "STATE_UPDATE#4"}
+	if got := client.received(t); !reflect.DeepEqual(got, want) {
+		t.Errorf("reconnected client received %v, want %v", got, want)
+	}
+}
+
+func TestHub_ResumeFallsBackToSnapshot(t *testing.T) {
+	h := New()
+	gameID := uuid.New()
+	manager := &fakeManager{snapshots: make(chan Client, 1)}
+	h.RegisterGameManager(gameID, manager)
+
+	for i := 0; i < ReplayBufferSize+2; i++ {
+		h.deliver(&BroadcastMessage{GameID: gameID, Message: message.NewServerMessage(message.MessageTypeQuestionReveal, nil)})
+	}
+
+	client := &fakeClient{userID: uuid.New(), gameID: gameID}
+	h.resumeClient(client, 1)
+
+	select {
+	case <-manager.snapshots:
+	case <-time.After(time.Second):
+		t.Fatal("resumeClient() did not request a snapshot")
+	}
+
+	want := []string{"STATE_UPDATE#" + strconv.Itoa(ReplayBufferSize+2)}
+	if got := client.received(t); !reflect.DeepEqual(got, want) {
+		t.Errorf("client received %v, want %v", got, want)
+	}
+}
+
+func TestHub_RegisterDoesNotHoldLockWhileNotifyingManager(t *testing.T) {
+	h := New()
+	gameID := uuid.New()
+	manager := &broadcastingManager{fakeManager: fakeManager{snapshots: make(chan Client, 1)}, hub: h, gameID: gameID}
+	h.RegisterGameManager(gameID, manager)
+
+	client := &fakeClient{userID: uuid.New(), gameID: gameID}
+	done := make(chan struct{})
+	go func() {
+		h.registerClient(client)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("registerClient() deadlocked on a broadcast from SetPlayerConnected")
+	}
+	select {
+	case <-manager.snapshots:
+	case <-time.After(time.Second):
+		t.Fatal("registerClient() did not request a snapshot")
+	}
+
+	want := []string{"STATE_UPDATE#1", "STATE_UPDATE#1"}
+	if got := client.received(t); !reflect.DeepEqual(got, want) {
+		t.Errorf("client received %v, want %v", got, want)
+	}
+}
+
+func TestHub_TransientMessagesAreNotReplayed(t *testing.T) {
+	h := New()
+	gameID := uuid.New()
+	h.RegisterGameManager(gameID, &fakeManager{snapshots: make(chan Client, 1)})
+
+	online := &fakeClient{userID: uuid.New(), gameID: gameID}
+	h.games[gameID] = map[Client]bool{online: true}
+	dropped := &fakeClient{userID: uuid.New(), gameID: gameID}
+
+	progress := message.NewServerMessage(message.MessageTypeQuestionReveal, nil)
+	progress.Transient = true
+	h.Broadcast(gameID, message.NewServerMessage(message.MessageTypeQuestionSelected, nil))
+	for i := 0; i < ReplayBufferSize; i++ {
+		h.Broadcast(gameID, progress)
+	}
+	h.Broadcast(gameID, message.NewServerMessage(message.MessageTypeQuestionReveal, nil))
+
+	if got := len(online.received(t)); got != ReplayBufferSize+2 {
+		t.Errorf("online client received %d messages, want %d", got, ReplayBufferSize+2)
+	}
+	if got := online.received(t)[1]; got != "QUESTION_REVEAL#0" {
+		t.Errorf("transient message = %s, want it without seq", got)
+	}
+
+	h.resumeClient(dropped, 0)
+
+	want := []string{"QUESTION_SELECTED#1", "QUESTION_REVEAL#2"}
+	if got := dropped.received(t); !reflect.DeepEqual(got, want) {
+		t.Errorf("resumed client received %v, want %v", got, want)
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/google/uuid"
 	domainGame "sigame/game/internal/domain/game"
+	"sigame/game/internal/domain/player"
 )
 
 func NewClientMessage(data []byte) (*ClientMessage, error) {
@@ -36,12 +37,13 @@ func NewPingMessage(serverTime int64) *ServerMessage {
 	})
 }
 
-func NewButtonPressedMessage(winnerID uuid.UUID, winnerName string, reactionTimeMS int64, allPresses []PressInfo) *ServerMessage {
+func NewButtonPressedMessage(winnerID uuid.UUID, winnerName string, reactionTimeMS int64, allPresses []PressInfo, falseStarts []FalseStartInfo) *ServerMessage {
 	return NewServerMessage(MessageTypeButtonPressed, ButtonPressedPayload{
 		WinnerID:       winnerID,
 		WinnerName:     winnerName,
 		ReactionTimeMS: reactionTimeMS,
 		AllPresses:     allPresses,
+		FalseStarts:    falseStarts,
 	})
 }
 
@@ -56,6 +58,21 @@ func NewAnswerResultMessage(userID uuid.UUID, username string, correct bool, ans
 	})
 }
 
+func NewRoundCompleteMessage(roundNumber int, scores []player.Score, nextRound *int) *ServerMessage {
+	return NewServerMessage(MessageTypeRoundComplete, RoundCompletePayload{
+		RoundNumber: roundNumber,
+		Scores:      scores,
+		NextRound:   nextRound,
+	})
+}
+
+func NewGameCompleteMessage(winners, scores []player.Score) *ServerMessage {
+	return NewServerMessage(MessageTypeGameComplete, GameCompletePayload{
+		Winners: winners,
+		Scores:  scores,
+	})
+}
+
 func NewErrorMessage(message, code string) *ServerMessage {
 	return NewServerMessage(MessageTypeError, ErrorPayload{
 		Message: message,
@@ -63,6 +80,14 @@ func NewErrorMessage(message, code string) *ServerMessage {
 	})
 }
 
+func NewReplyErrorMessage(message, code, replyTo string) *ServerMessage {
+	return NewServerMessage(MessageTypeError, ErrorPayload{
+		Message: message,
+		Code:    code,
+		ReplyTo: replyTo,
+	})
+}
+
 func NewRoundMediaManifestMessage(round int, media []MediaItem, totalSize int64) *ServerMessage {
 	return NewServerMessage(MessageTypeRoundMediaManifest, RoundMediaManifestPayload{
 		Round:      round,
@@ -82,21 +107,43 @@ func NewStartMediaMessage(mediaID, mediaType, url string, startAt, durationMS in
 	})
 }
 
-func NewSecretTransferredMessage(fromUserID uuid.UUID, fromUsername string, toUserID uuid.UUID, toUsername string) *ServerMessage {
+func NewSecretTransferredMessage(fromUserID uuid.UUID, fromUsername string, toUserID uuid.UUID, toUsername string, theme string, prices []int) *ServerMessage {
 	return NewServerMessage(MessageTypeSecretTransferred, SecretTransferredPayload{
 		FromUserID:   fromUserID,
 		FromUsername: fromUsername,
 		ToUserID:     toUserID,
 		ToUsername:   toUsername,
+		Theme:        theme,
+		Prices:       prices,
 	})
 }
 
-func NewStakePlacedMessage(userID uuid.UUID, username string, amount int, allIn bool) *ServerMessage {
+func NewStakePlacedMessage(userID uuid.UUID, username string, amount int, allIn, passed bool, leader, nextTurn *uuid.UUID) *ServerMessage {
 	return NewServerMessage(MessageTypeStakePlaced, StakePlacedPayload{
 		UserID:   userID,
 		Username: username,
 		Amount:   amount,
 		AllIn:    allIn,
+		Passed:   passed,
+		Leader:   leader,
+		NextTurn: nextTurn,
+	})
+}
+
+func NewFalseStartMessage(userID uuid.UUID, lockoutMS int64) *ServerMessage {
+	return NewServerMessage(MessageTypeFalseStart, FalseStartPayload{
+		UserID:    userID,
+		LockoutMS: lockoutMS,
+	})
+}
+
+func NewQuestionRevealMessage(questionID string, revealedChars, totalChars int, elapsedMS, readTimeMS int64) *ServerMessage {
+	return NewServerMessage(MessageTypeQuestionReveal, QuestionRevealPayload{
+		QuestionID:    questionID,
+		RevealedChars: revealedChars,
+		TotalChars:    totalChars,
+		ElapsedMS:     elapsedMS,
+		ReadTimeMS:    readTimeMS,
 	})
 }
 
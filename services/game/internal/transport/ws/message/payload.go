@@ -0,0 +1,98 @@
+package message
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+var ErrInvalidPayload = errors.New("invalid payload")
+
+type payloadValidator interface {
+	Validate() error
+}
+
+func DecodePayload(data []byte, v interface{}) error {
+	data = bytes.TrimSpace(data)
+	if len(data) == 0 || bytes.Equal(data, []byte("null")) {
+		data = []byte("{}")
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return invalidPayload("payload must be a JSON object")
+	}
+	if err := checkRequired(fields, v); err != nil {
+		return err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(v); err != nil {
+		var typeErr *json.UnmarshalTypeError
+		if errors.As(err, &typeErr) && typeErr.Field != "" {
+			return invalidPayload("%s must be of type %s", typeErr.Field, typeErr.Type)
+		}
+		return invalidPayload("%s", strings.TrimPrefix(err.Error(), "json: "))
+	}
+
+	if validator, ok := v.(payloadValidator); ok {
+		if err := validator.Validate(); err != nil {
+			return invalidPayload("%s", err.Error())
+		}
+	}
+	return nil
+}
+
+func checkRequired(fields map[string]json.RawMessage, v interface{}) error {
+	t := reflect.TypeOf(v)
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil
+	}
+
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.Tag.Get("binding") != "required" {
+			continue
+		}
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+		if name == "" {
+			name = field.Name
+		}
+		if raw, ok := fields[name]; !ok || bytes.Equal(raw, []byte("null")) {
+			return invalidPayload("%s is required", name)
+		}
+	}
+	return nil
+}
+
+func invalidPayload(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %s", ErrInvalidPayload, fmt.Sprintf(format, args...))
+}
+
+func (p PlaceStakePayload) Validate() error {
+	if !p.AllIn && p.Amount <= 0 {
+		return errors.New("amount must be positive")
+	}
+	return nil
+}
+
+func (p PressButtonPayload) Validate() error {
+	if p.ClientTime < 0 {
+		return errors.New("client_time must be a unix timestamp in milliseconds")
+	}
+	return nil
+}
+
+func (p AdjustScorePayload) Validate() error {
+	if p.Delta == 0 {
+		return errors.New("delta must be a non-zero integer")
+	}
+	return nil
+}
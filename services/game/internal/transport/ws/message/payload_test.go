@@ -0,0 +1,61 @@
+package message
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+)
+
+func TestDecodePayload_Valid(t *testing.T) {
+	userID := uuid.New()
+
+	var judge JudgeAnswerPayload
+	data := []byte(`{"user_id":"` + userID.String() + `","correct":false}`)
+	if err := DecodePayload(data, &judge); err != nil {
+		t.Fatalf("DecodePayload() error = %v", err)
+	}
+	if judge.UserID != userID || judge.Correct {
+		t.Errorf("DecodePayload() = %+v, want user %s and correct=false", judge, userID)
+	}
+
+	var empty EmptyPayload
+	for _, data := range [][]byte{nil, []byte("null"), []byte("{}")} {
+		if err := DecodePayload(data, &empty); err != nil {
+			t.Errorf("DecodePayload(%q) into EmptyPayload error = %v", data, err)
+		}
+	}
+
+	var stake PlaceStakePayload
+	if err := DecodePayload([]byte(`{"all_in":true}`), &stake); err != nil || !stake.AllIn {
+		t.Errorf("DecodePayload() all-in stake = %+v, error = %v", stake, err)
+	}
+}
+
+func TestDecodePayload_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		data    string
+		payload interface{}
+	}{
+		{name: "missing required field", data: `{"user_id":"` + uuid.NewString() + `"}`, payload: &JudgeAnswerPayload{}},
+		{name: "null required field", data: `{"answer":null}`, payload: &SubmitAnswerPayload{}},
+		{name: "unknown field", data: `{"answer":"a","score":100}`, payload: &SubmitAnswerPayload{}},
+		{name: "wrong type", data: `{"price":"300"}`, payload: &SelectSecretPricePayload{}},
+		{name: "fractional integer", data: `{"user_id":"` + uuid.NewString() + `","delta":1.5}`, payload: &AdjustScorePayload{}},
+		{name: "malformed uuid", data: `{"target_user_id":"nope"}`, payload: &TransferSecretPayload{}},
+		{name: "not an object", data: `[1,2]`, payload: &EmptyPayload{}},
+		{name: "validator", data: `{"amount":0}`, payload: &PlaceStakePayload{}},
+		{name: "negative client time", data: `{"client_time":-1}`, payload: &PressButtonPayload{}},
+		{name: "zero delta", data: `{"user_id":"` + uuid.NewString() + `","delta":0}`, payload: &AdjustScorePayload{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := DecodePayload([]byte(tt.data), tt.payload)
+			if !errors.Is(err, ErrInvalidPayload) {
+				t.Errorf("DecodePayload(%s) error = %v, want ErrInvalidPayload", tt.data, err)
+			}
+		})
+	}
+}
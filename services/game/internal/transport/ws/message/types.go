@@ -1,6 +1,8 @@
 package message
 
 import (
+	"encoding/json"
+
 	"github.com/google/uuid"
 	domainGame "sigame/game/internal/domain/game"
 	"sigame/game/internal/domain/player"
@@ -9,16 +11,30 @@ import (
 type MessageType string
 
 const (
+	MessageTypeReady MessageType = "READY"
 	MessageTypeSelectQuestion MessageType = "SELECT_QUESTION"
 	MessageTypePressButton MessageType = "PRESS_BUTTON"
 	MessageTypeSubmitAnswer MessageType = "SUBMIT_ANSWER"
 	MessageTypeJudgeAnswer MessageType = "JUDGE_ANSWER"
 	MessageTypePong MessageType = "PONG"
+	MessageTypeResume MessageType = "RESUME"
 	MessageTypeMediaLoadProgress MessageType = "MEDIA_LOAD_PROGRESS"
 	MessageTypeMediaLoadComplete MessageType = "MEDIA_LOAD_COMPLETE"
 	MessageTypeTransferSecret MessageType = "TRANSFER_SECRET"
 	MessageTypePlaceStake MessageType = "PLACE_STAKE"
+	MessageTypePassStake MessageType = "PASS_STAKE"
 	MessageTypeSubmitForAllAnswer MessageType = "SUBMIT_FOR_ALL_ANSWER"
+	MessageTypeSelectSecretPrice MessageType = "SELECT_SECRET_PRICE"
+	MessageTypeRemoveFinalTheme MessageType = "REMOVE_FINAL_THEME"
+	MessageTypePlaceFinalStake MessageType = "PLACE_FINAL_STAKE"
+	MessageTypeSubmitFinalAnswer MessageType = "SUBMIT_FINAL_ANSWER"
+	MessageTypeJudgeFinalAnswer MessageType = "JUDGE_FINAL_ANSWER"
+	MessageTypePauseGame MessageType = "PAUSE_GAME"
+	MessageTypeResumeGame MessageType = "RESUME_GAME"
+	MessageTypeAdjustScore MessageType = "ADJUST_SCORE"
+	MessageTypeKickPlayer MessageType = "KICK_PLAYER"
+	MessageTypeSkipQuestion MessageType = "SKIP_QUESTION"
+	MessageTypeEndRound MessageType = "END_ROUND"
 
 	MessageTypeStateUpdate MessageType = "STATE_UPDATE"
 	MessageTypeQuestionSelected MessageType = "QUESTION_SELECTED"
@@ -33,40 +49,73 @@ const (
 	MessageTypeSecretTransferred MessageType = "SECRET_TRANSFERRED"
 	MessageTypeStakePlaced MessageType = "STAKE_PLACED"
 	MessageTypeForAllResults MessageType = "FOR_ALL_RESULTS"
+	MessageTypeFalseStart MessageType = "FALSE_START"
+	MessageTypeQuestionReveal MessageType = "QUESTION_REVEAL"
+)
+
+const (
+	ErrorCodeInvalidPhase        = "INVALID_PHASE"
+	ErrorCodeNotYourTurn         = "NOT_YOUR_TURN"
+	ErrorCodeNotHost             = "NOT_HOST"
+	ErrorCodeNotAllowed          = "NOT_ALLOWED"
+	ErrorCodeInvalidPayload      = "INVALID_PAYLOAD"
+	ErrorCodeQuestionUnavailable = "QUESTION_UNAVAILABLE"
+	ErrorCodePlayerNotFound      = "PLAYER_NOT_FOUND"
+	ErrorCodeInvalidStake        = "INVALID_STAKE"
+	ErrorCodeAlreadySubmitted    = "ALREADY_SUBMITTED"
+	ErrorCodeGamePaused          = "GAME_PAUSED"
+	ErrorCodeUnknownMessage      = "UNKNOWN_MESSAGE"
 )
 
 type ClientMessage struct {
-	Type    MessageType            `json:"type"`
-	UserID  uuid.UUID              `json:"user_id"`
-	GameID  uuid.UUID              `json:"game_id"`
-	Payload map[string]interface{} `json:"payload,omitempty"`
+	ID      string          `json:"id,omitempty"`
+	Type    MessageType     `json:"type"`
+	UserID  uuid.UUID       `json:"user_id"`
+	GameID  uuid.UUID       `json:"game_id"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+func (m *ClientMessage) GetID() string {
+	return m.ID
 }
 
 func (m *ClientMessage) GetType() string {
 	return string(m.Type)
 }
 
-func (m *ClientMessage) GetPayload() map[string]interface{} {
-	return m.Payload
+func (m *ClientMessage) DecodePayload(v interface{}) error {
+	return DecodePayload(m.Payload, v)
 }
 
 type ServerMessage struct {
-	Type    MessageType `json:"type"`
-	Payload interface{} `json:"payload,omitempty"`
+	Type      MessageType `json:"type"`
+	Seq       uint64      `json:"seq,omitempty"`
+	Payload   interface{} `json:"payload,omitempty"`
+	Transient bool        `json:"-"`
 }
 
+type EmptyPayload struct{}
+
 type SelectQuestionPayload struct {
-	ThemeID    string `json:"theme_id"`
-	QuestionID string `json:"question_id"`
+	ThemeID    string `json:"theme_id" binding:"required"`
+	QuestionID string `json:"question_id" binding:"required"`
 }
 
 type SubmitAnswerPayload struct {
-	Answer string `json:"answer"`
+	Answer string `json:"answer" binding:"required"`
 }
 
 type JudgeAnswerPayload struct {
-	UserID  uuid.UUID `json:"user_id"`
-	Correct bool      `json:"correct"`
+	UserID  uuid.UUID `json:"user_id" binding:"required"`
+	Correct bool      `json:"correct" binding:"required"`
+}
+
+type PressButtonPayload struct {
+	ClientTime int64 `json:"client_time"`
+}
+
+type ResumePayload struct {
+	LastSeq uint64 `json:"last_seq" binding:"required"`
 }
 
 type PingPayload struct {
@@ -74,7 +123,7 @@ type PingPayload struct {
 }
 
 type PongPayload struct {
-	ServerTime int64 `json:"server_time"`
+	ServerTime int64 `json:"server_time" binding:"required"`
 	ClientTime int64 `json:"client_time"`
 }
 
@@ -84,11 +133,17 @@ type PressInfo struct {
 	TimeMS   int64     `json:"time_ms"`
 }
 
+type FalseStartInfo struct {
+	UserID   uuid.UUID `json:"user_id"`
+	Username string    `json:"username"`
+}
+
 type ButtonPressedPayload struct {
-	WinnerID       uuid.UUID   `json:"winner_id"`
-	WinnerName     string      `json:"winner_name"`
-	ReactionTimeMS int64       `json:"reaction_time_ms"`
-	AllPresses     []PressInfo `json:"all_presses"`
+	WinnerID       uuid.UUID        `json:"winner_id"`
+	WinnerName     string           `json:"winner_name"`
+	ReactionTimeMS int64            `json:"reaction_time_ms"`
+	AllPresses     []PressInfo      `json:"all_presses"`
+	FalseStarts    []FalseStartInfo `json:"false_starts,omitempty"`
 }
 
 type AnswerResultPayload struct {
@@ -114,6 +169,7 @@ type GameCompletePayload struct {
 type ErrorPayload struct {
 	Message string `json:"message"`
 	Code    string `json:"code,omitempty"`
+	ReplyTo string `json:"reply_to,omitempty"`
 }
 
 type MediaItem struct {
@@ -157,7 +213,11 @@ type StartMediaPayload struct {
 }
 
 type TransferSecretPayload struct {
-	TargetUserID uuid.UUID `json:"target_user_id"`
+	TargetUserID uuid.UUID `json:"target_user_id" binding:"required"`
+}
+
+type SelectSecretPricePayload struct {
+	Price int `json:"price" binding:"required"`
 }
 
 type PlaceStakePayload struct {
@@ -166,7 +226,33 @@ type PlaceStakePayload struct {
 }
 
 type SubmitForAllAnswerPayload struct {
-	Answer string `json:"answer"`
+	Answer string `json:"answer" binding:"required"`
+}
+
+type RemoveFinalThemePayload struct {
+	ThemeID string `json:"theme_id" binding:"required"`
+}
+
+type PlaceFinalStakePayload struct {
+	Amount int `json:"amount" binding:"required"`
+}
+
+type SubmitFinalAnswerPayload struct {
+	Answer string `json:"answer" binding:"required"`
+}
+
+type JudgeFinalAnswerPayload struct {
+	UserID  uuid.UUID `json:"user_id" binding:"required"`
+	Correct bool      `json:"correct" binding:"required"`
+}
+
+type AdjustScorePayload struct {
+	UserID uuid.UUID `json:"user_id" binding:"required"`
+	Delta  int       `json:"delta" binding:"required"`
+}
+
+type KickPlayerPayload struct {
+	UserID uuid.UUID `json:"user_id" binding:"required"`
 }
 
 type SecretTransferredPayload struct {
@@ -174,13 +260,31 @@ type SecretTransferredPayload struct {
 	FromUsername string    `json:"from_username"`
 	ToUserID     uuid.UUID `json:"to_user_id"`
 	ToUsername   string    `json:"to_username"`
+	Theme        string    `json:"theme"`
+	Prices       []int     `json:"prices"`
 }
 
 type StakePlacedPayload struct {
-	UserID   uuid.UUID `json:"user_id"`
-	Username string    `json:"username"`
-	Amount   int       `json:"amount"`
-	AllIn    bool      `json:"all_in"`
+	UserID   uuid.UUID  `json:"user_id"`
+	Username string     `json:"username"`
+	Amount   int        `json:"amount"`
+	AllIn    bool       `json:"all_in"`
+	Passed   bool       `json:"passed"`
+	Leader   *uuid.UUID `json:"leader,omitempty"`
+	NextTurn *uuid.UUID `json:"next_turn,omitempty"`
+}
+
+type FalseStartPayload struct {
+	UserID    uuid.UUID `json:"user_id"`
+	LockoutMS int64     `json:"lockout_ms"`
+}
+
+type QuestionRevealPayload struct {
+	QuestionID    string `json:"question_id"`
+	RevealedChars int    `json:"revealed_chars"`
+	TotalChars    int    `json:"total_chars"`
+	ElapsedMS     int64  `json:"elapsed_ms"`
+	ReadTimeMS    int64  `json:"read_time_ms"`
 }
 
 type ForAllResultsPayload struct {